@@ -0,0 +1,332 @@
+// Package agent turns the one-shot login->navigate->search->connect->message
+// pipeline main() used to run inline into a long-lived scheduler: an Agent
+// holds the browser session and dispatches pluggable Tasks according to
+// config-driven cron schedules, spreading activity across a day instead of
+// bursting it all at once - closer to the "casual browsing" spirit already
+// encoded in the navigation patterns.
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-rod/rod"
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/config"
+	"linkedin-automation-tool/metrics"
+	"linkedin-automation-tool/storage"
+)
+
+// ErrStopped is returned by Submit when the agent has already shut down.
+var ErrStopped = errors.New("agent: stopped")
+
+const (
+	baseBackoff = time.Minute
+	maxBackoff  = time.Hour
+	// maxStartJitter bounds how far a dispatched task's start is delayed so
+	// schedules firing in the same minute don't all hit the page at once.
+	maxStartJitter = 30 * time.Second
+)
+
+// sessionOperation is one page operation waiting to run through sessionq,
+// which the session worker goroutine drains one at a time so Tasks never
+// touch the shared *rod.Page concurrently.
+type sessionOperation struct {
+	run  func(ctx context.Context, page *rod.Page) error
+	done chan error
+}
+
+// taskBackoff tracks consecutive failures for one scheduled task, used to
+// compute an exponential cool-down before the scheduler tries it again.
+type taskBackoff struct {
+	consecutiveFailures int
+	nextAllowed         time.Time
+}
+
+// Agent is a long-lived driver for a single logged-in LinkedIn session.
+type Agent struct {
+	page             *rod.Page
+	store            storage.StateStore
+	cfg              config.Config
+	metricsCollector *metrics.Collector
+	log              *zap.SugaredLogger
+
+	sessionq  chan sessionOperation
+	ready     chan struct{}
+	readyOnce sync.Once
+
+	tasks map[string]Task
+
+	mu       sync.Mutex
+	backoffs map[string]*taskBackoff
+	lastRun  map[string]string // "task|cron" -> "YYYY-MM-DD HH:MM" last dispatch, guards a tick firing a schedule twice
+
+	profilesMu sync.Mutex
+	profiles   []string // most recent SearchTask results, consumed by ConnectTask/MessageTask
+
+	paused int32 // atomic bool; gates dispatchDue, flipped by the control-plane API
+
+	stop    chan struct{}
+	stopped sync.Once
+	wg      sync.WaitGroup
+}
+
+// NewAgent builds an Agent around page. Call RegisterTask for each task
+// named in cfg.Schedules, then Start.
+func NewAgent(page *rod.Page, store storage.StateStore, cfg config.Config, metricsCollector *metrics.Collector, log *zap.SugaredLogger) *Agent {
+	return &Agent{
+		page:             page,
+		store:            store,
+		cfg:              cfg,
+		metricsCollector: metricsCollector,
+		log:              log,
+		sessionq:         make(chan sessionOperation),
+		ready:            make(chan struct{}),
+		tasks:            make(map[string]Task),
+		backoffs:         make(map[string]*taskBackoff),
+		lastRun:          make(map[string]string),
+		stop:             make(chan struct{}),
+	}
+}
+
+// RegisterTask makes task available to schedules whose Task field matches name.
+func (a *Agent) RegisterTask(name string, task Task) {
+	a.tasks[name] = task
+}
+
+// MarkReady signals that login and stealth setup are complete, unblocking
+// the scheduler loop. Safe to call more than once.
+func (a *Agent) MarkReady() {
+	a.readyOnce.Do(func() { close(a.ready) })
+}
+
+// Start launches the session worker and scheduler loop. Call Stop to shut
+// them down.
+func (a *Agent) Start(ctx context.Context) {
+	a.wg.Add(2)
+	go a.runSessionWorker(ctx)
+	go a.runScheduler(ctx)
+}
+
+// Stop signals both goroutines to exit and waits for them to drain. Safe to
+// call more than once.
+func (a *Agent) Stop() {
+	a.stopped.Do(func() { close(a.stop) })
+	a.wg.Wait()
+}
+
+// Submit enqueues a page operation and blocks for its result, serializing it
+// against every other operation on the shared page via sessionq.
+func (a *Agent) Submit(ctx context.Context, run func(ctx context.Context, page *rod.Page) error) error {
+	op := sessionOperation{run: run, done: make(chan error, 1)}
+
+	select {
+	case a.sessionq <- op:
+	case <-a.stop:
+		return ErrStopped
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-op.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Profiles returns the most recent SearchTask results.
+func (a *Agent) Profiles() []string {
+	a.profilesMu.Lock()
+	defer a.profilesMu.Unlock()
+	return a.profiles
+}
+
+// SetProfiles replaces the profile list ConnectTask/MessageTask draw from.
+func (a *Agent) SetProfiles(profiles []string) {
+	a.profilesMu.Lock()
+	defer a.profilesMu.Unlock()
+	a.profiles = profiles
+}
+
+// Pause stops the scheduler from dispatching new tasks. Tasks already
+// running are left to finish. Safe to call from any goroutine.
+func (a *Agent) Pause() {
+	atomic.StoreInt32(&a.paused, 1)
+}
+
+// Resume undoes Pause.
+func (a *Agent) Resume() {
+	atomic.StoreInt32(&a.paused, 0)
+}
+
+// Paused reports whether the scheduler is currently paused.
+func (a *Agent) Paused() bool {
+	return atomic.LoadInt32(&a.paused) != 0
+}
+
+// Dispatch runs the named task immediately in the background, bypassing the
+// cron schedule - used by the control-plane API to fire ad-hoc campaigns.
+// It still goes through the same jitter and backoff tracking as a scheduled
+// run, keyed separately under "adhoc|<name>" so it doesn't share state with
+// any cron-driven schedule for the same task.
+func (a *Agent) Dispatch(name string, params map[string]interface{}) error {
+	task, ok := a.tasks[name]
+	if !ok {
+		return fmt.Errorf("agent: no task registered named %q", name)
+	}
+	go a.runTask(context.Background(), "adhoc|"+name, task, params)
+	return nil
+}
+
+// Schedules returns the configured cron schedules, for status reporting.
+func (a *Agent) Schedules() []config.ScheduleConfig {
+	return a.cfg.Schedules
+}
+
+func (a *Agent) runSessionWorker(ctx context.Context) {
+	defer a.wg.Done()
+	for {
+		select {
+		case <-a.stop:
+			return
+		case <-ctx.Done():
+			return
+		case op := <-a.sessionq:
+			op.done <- op.run(ctx, a.page)
+		}
+	}
+}
+
+func (a *Agent) runScheduler(ctx context.Context) {
+	defer a.wg.Done()
+
+	select {
+	case <-a.ready:
+	case <-a.stop:
+		return
+	case <-ctx.Done():
+		return
+	}
+
+	a.log.Infow("agent scheduler starting", "schedules", len(a.cfg.Schedules))
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.stop:
+			return
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			a.dispatchDue(ctx, now)
+		}
+	}
+}
+
+// dispatchDue checks every configured schedule against now and, for each
+// one that's due, not already backing off, and not already dispatched this
+// minute, spawns runTask in its own goroutine.
+func (a *Agent) dispatchDue(ctx context.Context, now time.Time) {
+	if a.Paused() {
+		return
+	}
+
+	minuteKey := now.Format("2006-01-02 15:04")
+
+	for _, sched := range a.cfg.Schedules {
+		cs, err := parseCron(sched.Cron)
+		if err != nil {
+			a.log.Warnw("invalid cron expression, skipping schedule", "task", sched.Task, "cron", sched.Cron, "error", err)
+			continue
+		}
+		if !cs.Matches(now) {
+			continue
+		}
+
+		dedupeKey := sched.Task + "|" + sched.Cron
+
+		a.mu.Lock()
+		if a.lastRun[dedupeKey] == minuteKey {
+			a.mu.Unlock()
+			continue
+		}
+		a.lastRun[dedupeKey] = minuteKey
+
+		bo := a.backoffs[dedupeKey]
+		if bo == nil {
+			bo = &taskBackoff{}
+			a.backoffs[dedupeKey] = bo
+		}
+		if now.Before(bo.nextAllowed) {
+			a.mu.Unlock()
+			a.log.Infow("schedule due but task is backing off, skipping this tick", "task", sched.Task, "nextAllowed", bo.nextAllowed)
+			continue
+		}
+		a.mu.Unlock()
+
+		task, ok := a.tasks[sched.Task]
+		if !ok {
+			a.log.Warnw("no task registered for schedule, skipping", "task", sched.Task)
+			continue
+		}
+
+		go a.runTask(ctx, dedupeKey, task, sched.Params)
+	}
+}
+
+// runTask jitters the start time, runs task, and updates its backoff state
+// from the result.
+func (a *Agent) runTask(ctx context.Context, dedupeKey string, task Task, params map[string]interface{}) {
+	jitter := time.Duration(rand.Int63n(int64(maxStartJitter)))
+	select {
+	case <-time.After(jitter):
+	case <-a.stop:
+		return
+	case <-ctx.Done():
+		return
+	}
+
+	a.log.Infow("dispatching scheduled task", "task", task.Name(), "jitter", jitter)
+
+	start := time.Now()
+	err := task.Run(ctx, a, params)
+	a.metricsCollector.ObserveActionLatency(task.Name(), time.Since(start))
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	bo := a.backoffs[dedupeKey]
+	if bo == nil {
+		bo = &taskBackoff{}
+		a.backoffs[dedupeKey] = bo
+	}
+
+	if err != nil {
+		bo.consecutiveFailures++
+		delay := baseBackoff * time.Duration(int64(1)<<uint(bo.consecutiveFailures-1))
+		if delay > maxBackoff {
+			delay = maxBackoff
+		}
+		bo.nextAllowed = time.Now().Add(delay)
+		a.log.Warnw("scheduled task failed, backing off",
+			"task", task.Name(),
+			"error", err,
+			"consecutiveFailures", bo.consecutiveFailures,
+			"nextAllowed", bo.nextAllowed,
+		)
+		return
+	}
+
+	bo.consecutiveFailures = 0
+	bo.nextAllowed = time.Time{}
+	a.log.Infow("scheduled task completed", "task", task.Name())
+}
@@ -0,0 +1,84 @@
+package agent
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a minimal 5-field (minute hour dom month dow) cron
+// matcher: each field is either "*" or a comma-separated list of integers.
+// It intentionally doesn't support step (*/5) or range (1-5) syntax - the
+// schedules an Agent runs are a handful of fixed daily slots, not a general
+// job scheduler.
+type cronSchedule struct {
+	minute []int // nil means "*"
+	hour   []int
+	dom    []int
+	month  []int
+	dow    []int
+}
+
+func parseCron(expr string) (cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return cronSchedule{}, fmt.Errorf("cron expression must have 5 fields, got %d: %q", len(fields), expr)
+	}
+
+	var cs cronSchedule
+	var err error
+	if cs.minute, err = parseCronField(fields[0]); err != nil {
+		return cronSchedule{}, err
+	}
+	if cs.hour, err = parseCronField(fields[1]); err != nil {
+		return cronSchedule{}, err
+	}
+	if cs.dom, err = parseCronField(fields[2]); err != nil {
+		return cronSchedule{}, err
+	}
+	if cs.month, err = parseCronField(fields[3]); err != nil {
+		return cronSchedule{}, err
+	}
+	if cs.dow, err = parseCronField(fields[4]); err != nil {
+		return cronSchedule{}, err
+	}
+	return cs, nil
+}
+
+func parseCronField(field string) ([]int, error) {
+	if field == "*" {
+		return nil, nil
+	}
+
+	var vals []int
+	for _, part := range strings.Split(field, ",") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron field value %q: %w", part, err)
+		}
+		vals = append(vals, n)
+	}
+	return vals, nil
+}
+
+func cronFieldMatches(vals []int, v int) bool {
+	if vals == nil {
+		return true
+	}
+	for _, x := range vals {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches reports whether t falls within this schedule's minute.
+func (cs cronSchedule) Matches(t time.Time) bool {
+	return cronFieldMatches(cs.minute, t.Minute()) &&
+		cronFieldMatches(cs.hour, t.Hour()) &&
+		cronFieldMatches(cs.dom, t.Day()) &&
+		cronFieldMatches(cs.month, int(t.Month())) &&
+		cronFieldMatches(cs.dow, int(t.Weekday()))
+}
@@ -0,0 +1,38 @@
+package agent
+
+// Task params come from a schedule's config.yaml `params` map (arbitrary
+// YAML, so values arrive as string/int/float64/bool/etc.). These helpers
+// pull a typed value out with a fallback default instead of every Task
+// re-implementing the same type switch.
+
+func paramString(params map[string]interface{}, key, def string) string {
+	if v, ok := params[key]; ok {
+		if s, ok := v.(string); ok && s != "" {
+			return s
+		}
+	}
+	return def
+}
+
+func paramInt(params map[string]interface{}, key string, def int) int {
+	if v, ok := params[key]; ok {
+		switch n := v.(type) {
+		case int:
+			return n
+		case int64:
+			return int(n)
+		case float64:
+			return int(n)
+		}
+	}
+	return def
+}
+
+func paramBool(params map[string]interface{}, key string, def bool) bool {
+	if v, ok := params[key]; ok {
+		if b, ok := v.(bool); ok {
+			return b
+		}
+	}
+	return def
+}
@@ -0,0 +1,234 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-rod/rod"
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/connect"
+	"linkedin-automation-tool/messaging"
+	"linkedin-automation-tool/navigation"
+	"linkedin-automation-tool/search"
+)
+
+// Default daily send limits ConnectTask and MessageTask use when a schedule's
+// params don't set max_per_day.
+const (
+	MaxConnectionsPerDay = 10
+	MaxMessagesPerDay    = 5
+)
+
+// Task is one unit of scheduled work an Agent can dispatch. Implementations
+// should do all page interaction through a.Submit so it's serialized with
+// every other Task sharing the session.
+type Task interface {
+	Name() string
+	Run(ctx context.Context, a *Agent, params map[string]interface{}) error
+}
+
+// navigationPatterns maps the "pattern" param NavigatePatternTask accepts to
+// the predefined patterns in the navigation package.
+var navigationPatterns = map[string]navigation.NavigationPattern{
+	"quick_tour":      navigation.QuickTourPattern,
+	"networking":      navigation.NetworkingPattern,
+	"job_search":      navigation.JobSearchPattern,
+	"casual_browsing": navigation.CasualBrowsingPattern,
+}
+
+// SearchTask runs a LinkedIn people search and stores the results on the
+// Agent for ConnectTask/MessageTask to pick up.
+type SearchTask struct {
+	log *zap.SugaredLogger
+}
+
+// NewSearchTask builds a SearchTask.
+func NewSearchTask(log *zap.SugaredLogger) *SearchTask {
+	return &SearchTask{log: log}
+}
+
+// Name implements Task.
+func (t *SearchTask) Name() string { return "search" }
+
+// Run implements Task. Recognized params: keywords, job_title, company,
+// location, max_pages.
+func (t *SearchTask) Run(ctx context.Context, a *Agent, params map[string]interface{}) error {
+	searchParams := search.SearchParams{
+		Keywords: paramString(params, "keywords", "software engineer"),
+		JobTitle: paramString(params, "job_title", ""),
+		Company:  paramString(params, "company", ""),
+		Location: paramString(params, "location", "India"),
+		MaxPages: paramInt(params, "max_pages", 3),
+	}
+
+	var profiles []string
+	err := a.Submit(ctx, func(ctx context.Context, page *rod.Page) error {
+		var err error
+		profiles, err = search.FindPeople(ctx, page, a.store, searchParams, a.cfg.Timing, t.log)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("search: %w", err)
+	}
+
+	a.metricsCollector.AddProfilesFound(len(profiles))
+	a.SetProfiles(profiles)
+
+	filename := "data/search_results.txt"
+	if err := saveSearchResults(profiles, filename); err != nil {
+		t.log.Errorw("failed to save search results", "error", err)
+	} else {
+		t.log.Infow("search results saved", "filename", filename, "count", len(profiles))
+	}
+
+	return nil
+}
+
+// saveSearchResults saves profile URLs to a text file.
+func saveSearchResults(profiles []string, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+	defer file.Close()
+
+	header := fmt.Sprintf("# LinkedIn People Search Results\n# Generated: %s\n# Total Profiles: %d\n\n",
+		time.Now().Format("2006-01-02 15:04:05"),
+		len(profiles),
+	)
+	if _, err := file.WriteString(header); err != nil {
+		return fmt.Errorf("write header: %w", err)
+	}
+
+	for i, url := range profiles {
+		line := fmt.Sprintf("%d. %s\n", i+1, url)
+		if _, err := file.WriteString(line); err != nil {
+			return fmt.Errorf("write url: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ConnectTask sends connection requests to the profiles the last SearchTask
+// found.
+type ConnectTask struct {
+	log *zap.SugaredLogger
+}
+
+// NewConnectTask builds a ConnectTask.
+func NewConnectTask(log *zap.SugaredLogger) *ConnectTask {
+	return &ConnectTask{log: log}
+}
+
+// Name implements Task.
+func (t *ConnectTask) Name() string { return "connect" }
+
+// Run implements Task. Recognized params: max_per_day, use_personalized_notes,
+// note_template, wait_between_requests_ms.
+func (t *ConnectTask) Run(ctx context.Context, a *Agent, params map[string]interface{}) error {
+	profiles := a.Profiles()
+	if len(profiles) == 0 {
+		t.log.Info("no profiles to connect with, skipping")
+		return nil
+	}
+
+	reqCfg := connect.RequestConfig{
+		MaxPerDay:            paramInt(params, "max_per_day", MaxConnectionsPerDay),
+		UsePersonalizedNotes: paramBool(params, "use_personalized_notes", true),
+		NoteTemplate:         paramString(params, "note_template", "Hi {{name}}, I came across your profile and would love to connect with you. Looking forward to staying in touch!"),
+		WaitBetweenRequests:  paramInt(params, "wait_between_requests_ms", 8000),
+	}
+
+	var sentCount, skipCount, failedCount int
+	err := a.Submit(ctx, func(ctx context.Context, page *rod.Page) error {
+		var err error
+		sentCount, skipCount, failedCount, err = connect.SendRequests(ctx, page, profiles, a.store, reqCfg, a.cfg.Timing, t.log)
+		return err
+	})
+	a.metricsCollector.AddConnectionRequests("sent", sentCount)
+	a.metricsCollector.AddConnectionRequests("skipped", skipCount)
+	a.metricsCollector.AddConnectionRequests("failed", failedCount)
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+
+	return nil
+}
+
+// MessageTask sends follow-up messages to the profiles the last SearchTask
+// found that have since accepted a connection request.
+type MessageTask struct {
+	log *zap.SugaredLogger
+}
+
+// NewMessageTask builds a MessageTask.
+func NewMessageTask(log *zap.SugaredLogger) *MessageTask {
+	return &MessageTask{log: log}
+}
+
+// Name implements Task.
+func (t *MessageTask) Name() string { return "message" }
+
+// Run implements Task. Recognized params: max_per_day, max_per_hour,
+// max_per_week, message_template, wait_between_messages_ms, context.
+func (t *MessageTask) Run(ctx context.Context, a *Agent, params map[string]interface{}) error {
+	profiles := a.Profiles()
+	if len(profiles) == 0 {
+		t.log.Info("no profiles to message, skipping")
+		return nil
+	}
+
+	msgCfg := messaging.FollowUpConfig{
+		MaxPerDay:           paramInt(params, "max_per_day", MaxMessagesPerDay),
+		MaxPerHour:          paramInt(params, "max_per_hour", 0),
+		MaxPerWeek:          paramInt(params, "max_per_week", 0),
+		MessageTemplate:     paramString(params, "message_template", `Hi {{.FirstName | default "there"}}, thanks for connecting! I came across your profile and thought we might have some interesting synergies. Looking forward to staying in touch!`),
+		WaitBetweenMessages: paramInt(params, "wait_between_messages_ms", 15000),
+		Context:             paramString(params, "context", "software engineering"),
+	}
+
+	var sentCount int
+	err := a.Submit(ctx, func(ctx context.Context, page *rod.Page) error {
+		var err error
+		sentCount, err = messaging.SendFollowUps(ctx, page, profiles, a.store, msgCfg, a.cfg.Timing, nil, t.log)
+		return err
+	})
+	a.metricsCollector.AddMessagesSent(sentCount)
+	if err != nil {
+		return fmt.Errorf("message: %w", err)
+	}
+
+	return nil
+}
+
+// NavigatePatternTask runs a predefined navigation.NavigationPattern, useful
+// for scheduling idle "casual browsing" activity between the other tasks.
+type NavigatePatternTask struct {
+	log *zap.SugaredLogger
+}
+
+// NewNavigatePatternTask builds a NavigatePatternTask.
+func NewNavigatePatternTask(log *zap.SugaredLogger) *NavigatePatternTask {
+	return &NavigatePatternTask{log: log}
+}
+
+// Name implements Task.
+func (t *NavigatePatternTask) Name() string { return "navigate" }
+
+// Run implements Task. Recognized params: pattern (one of "quick_tour",
+// "networking", "job_search", "casual_browsing"; defaults to "casual_browsing").
+func (t *NavigatePatternTask) Run(ctx context.Context, a *Agent, params map[string]interface{}) error {
+	name := paramString(params, "pattern", "casual_browsing")
+	pattern, ok := navigationPatterns[name]
+	if !ok {
+		return fmt.Errorf("navigate: unknown pattern %q", name)
+	}
+
+	return a.Submit(ctx, func(ctx context.Context, page *rod.Page) error {
+		return navigation.ExecutePattern(ctx, page, pattern, a.cfg.Timing, a.metricsCollector, t.log)
+	})
+}
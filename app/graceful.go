@@ -0,0 +1,32 @@
+// Package app wires together the process-level concerns that don't belong
+// to any single automation subsystem: graceful shutdown and the admin HTTP
+// endpoints (/-/ready, /-/healthy, /-/metrics) ops tooling polls.
+package app
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/zap"
+)
+
+// NewGracefulContext returns a context cancelled on SIGINT, SIGTERM, or
+// SIGHUP, so callers can derive every timeout further down the call chain
+// (e.g. stealth.TypeHuman's per-keystroke context) from one cancellation
+// source instead of letting an in-progress action run to completion.
+func NewGracefulContext(log *zap.Logger) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+	go func() {
+		sig := <-sigCh
+		log.Sugar().Infow("shutdown signal received", "signal", sig.String())
+		signal.Stop(sigCh)
+		cancel()
+	}()
+
+	return ctx
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-rod/rod"
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/metrics"
+	"linkedin-automation-tool/storage"
+)
+
+// MonitorBrowser periodically pings browser and marks status unhealthy once
+// it stops responding, i.e. the browser process died. It returns once ctx is
+// cancelled.
+func MonitorBrowser(ctx context.Context, browser *rod.Browser, status *Status, interval time.Duration, log *zap.SugaredLogger) {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := browser.Pages(); err != nil {
+				log.Warnw("browser health check failed", "error", err)
+				status.SetUnhealthy("browser", fmt.Errorf("browser process unreachable: %w", err))
+				continue
+			}
+			status.SetUnhealthy("browser", nil)
+		}
+	}
+}
+
+// MonitorQuotaIntegrity periodically checks the daily-limit counters in
+// store and marks status unhealthy if one is corrupted. It returns once ctx
+// is cancelled.
+func MonitorQuotaIntegrity(ctx context.Context, store storage.StateStore, status *Status, interval time.Duration, log *zap.SugaredLogger) {
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := metrics.CheckQuotaIntegrity(ctx, store); err != nil {
+				log.Warnw("quota integrity check failed", "error", err)
+				status.SetUnhealthy("quota", err)
+				continue
+			}
+			status.SetUnhealthy("quota", nil)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/metrics"
+)
+
+// Serve starts the admin HTTP server exposing /-/ready, /-/healthy, and
+// /-/metrics, shutting down when ctx is cancelled. collector may be nil
+// (metrics.enabled=false), in which case /-/metrics 404s.
+func Serve(ctx context.Context, listen string, status *Status, collector *metrics.Collector, log *zap.SugaredLogger) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/-/ready", func(w http.ResponseWriter, r *http.Request) {
+		if !status.Ready() {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/-/healthy", func(w http.ResponseWriter, r *http.Request) {
+		if err := status.Err(); err != nil {
+			http.Error(w, "unhealthy: "+err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	})
+	if collector != nil {
+		mux.Handle("/-/metrics", promhttp.HandlerFor(collector.Registry(), promhttp.HandlerOpts{}))
+	}
+
+	srv := &http.Server{Addr: listen, Handler: mux}
+	go func() {
+		log.Infow("admin server listening", "addr", listen)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Errorw("admin server failed", "error", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Warnw("admin server shutdown error", "error", err)
+		}
+	}()
+}
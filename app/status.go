@@ -0,0 +1,59 @@
+package app
+
+import "sync"
+
+// Status is the shared health/readiness state the admin server reports.
+// Independent checks (browser liveness, checkpoint detection, quota
+// integrity) each own a named reason so one check clearing doesn't mask
+// another that's still failing.
+type Status struct {
+	mu      sync.RWMutex
+	ready   bool
+	reasons map[string]error
+}
+
+// NewStatus returns a Status that starts not-ready and healthy.
+func NewStatus() *Status {
+	return &Status{reasons: make(map[string]error)}
+}
+
+// MarkReady flips readiness on. It's meant to be called once the browser has
+// launched and authenticated; there's no corresponding "unready" transition
+// because a process that loses its browser mid-run should report unhealthy,
+// not unready.
+func (s *Status) MarkReady() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ready = true
+}
+
+// Ready reports whether MarkReady has been called.
+func (s *Status) Ready() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.ready
+}
+
+// SetUnhealthy records err as the reason check is currently failing. Passing
+// a nil err clears that check, leaving any other failing checks in place.
+func (s *Status) SetUnhealthy(check string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		delete(s.reasons, check)
+		return
+	}
+	s.reasons[check] = err
+}
+
+// Err returns one of the currently recorded unhealthy reasons, or nil if
+// every check is passing. Which reason is returned when several are failing
+// is unspecified - callers only need to know "healthy or not".
+func (s *Status) Err() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, err := range s.reasons {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-imap"
+	imapclient "github.com/emersion/go-imap/client"
+	"github.com/go-rod/rod"
+	"github.com/pquerna/otp/totp"
+	"go.uber.org/zap"
+)
+
+// ChallengeType classifies the LinkedIn checkpoint/challenge variant
+// currently on screen, so the right solver can be invoked.
+type ChallengeType string
+
+const (
+	ChallengeUnknown  ChallengeType = "unknown"
+	ChallengeTOTP     ChallengeType = "totp"
+	ChallengeEmailOTP ChallengeType = "email_otp"
+	ChallengeCaptcha  ChallengeType = "captcha"
+)
+
+// ChallengeSolver produces the answer for a given challenge variant.
+// Implementations may block (e.g. polling an inbox) but should honor ctx.
+type ChallengeSolver interface {
+	SolveTOTP(ctx context.Context) (string, error)
+	SolveEmailOTP(ctx context.Context) (string, error)
+	SolveCaptcha(ctx context.Context, imageURL string) (string, error)
+}
+
+// classifyChallenge inspects the current page DOM to decide which challenge
+// variant LinkedIn is presenting.
+func classifyChallenge(page *rod.Page) ChallengeType {
+	if _, err := page.Timeout(2 * time.Second).Element("input[name='pin'], input#input__phone_verification_pin, input[aria-label*='authenticator']"); err == nil {
+		return ChallengeTOTP
+	}
+	if _, err := page.Timeout(2 * time.Second).Element("input#input__email_verification_pin, input[name='email_pin']"); err == nil {
+		return ChallengeEmailOTP
+	}
+	if _, err := page.Timeout(2 * time.Second).Element("iframe[src*='recaptcha'], iframe[title*='captcha' i], div#captcha-internal"); err == nil {
+		return ChallengeCaptcha
+	}
+	return ChallengeUnknown
+}
+
+// resolveChallenge classifies the current checkpoint page, asks solver for
+// the right answer, fills it in, and submits. Returns an error if the
+// challenge could not be classified or the solver/submit step fails.
+func resolveChallenge(ctx context.Context, page *rod.Page, solver ChallengeSolver, log *zap.SugaredLogger) error {
+	if solver == nil {
+		return fmt.Errorf("checkpoint detected but no ChallengeSolver configured")
+	}
+
+	challengeType := classifyChallenge(page)
+	log.Infow("classified checkpoint challenge", "type", challengeType)
+
+	var code string
+	var err error
+	var inputSelector string
+
+	switch challengeType {
+	case ChallengeTOTP:
+		code, err = solver.SolveTOTP(ctx)
+		inputSelector = "input[name='pin'], input#input__phone_verification_pin, input[aria-label*='authenticator']"
+	case ChallengeEmailOTP:
+		code, err = solver.SolveEmailOTP(ctx)
+		inputSelector = "input#input__email_verification_pin, input[name='email_pin']"
+	case ChallengeCaptcha:
+		imgURL, _ := captchaImageURL(page)
+		code, err = solver.SolveCaptcha(ctx, imgURL)
+		inputSelector = "input#captcha-internal-response, input[name='captcha_response']"
+	default:
+		return fmt.Errorf("unrecognized checkpoint challenge")
+	}
+	if err != nil {
+		return fmt.Errorf("solve %s challenge: %w", challengeType, err)
+	}
+
+	input, err := page.Timeout(10 * time.Second).Element(inputSelector)
+	if err != nil {
+		return fmt.Errorf("find %s input: %w", challengeType, err)
+	}
+	if err := input.Input(code); err != nil {
+		return fmt.Errorf("fill %s code: %w", challengeType, err)
+	}
+
+	submit, err := page.Timeout(5 * time.Second).Element("button[type='submit']")
+	if err != nil {
+		return fmt.Errorf("find submit button: %w", err)
+	}
+	if err := submit.Click("left", 1); err != nil {
+		return fmt.Errorf("submit %s code: %w", challengeType, err)
+	}
+
+	log.Infow("submitted challenge response", "type", challengeType)
+	return nil
+}
+
+func captchaImageURL(page *rod.Page) (string, error) {
+	el, err := page.Timeout(2 * time.Second).Element("img#captcha-internal-image, img[alt*='captcha' i]")
+	if err != nil {
+		return "", err
+	}
+	src, err := el.Attribute("src")
+	if err != nil || src == nil {
+		return "", fmt.Errorf("captcha image has no src")
+	}
+	return *src, nil
+}
+
+// TOTPSolver answers SolveTOTP from a configured authenticator seed. The
+// other challenge types are not supported by this solver.
+type TOTPSolver struct {
+	Secret string // base32 TOTP seed, as provisioned by LinkedIn's 2FA setup
+}
+
+func (s TOTPSolver) SolveTOTP(ctx context.Context) (string, error) {
+	if s.Secret == "" {
+		return "", fmt.Errorf("totp solver has no secret configured")
+	}
+	return totp.GenerateCode(s.Secret, time.Now())
+}
+
+func (s TOTPSolver) SolveEmailOTP(ctx context.Context) (string, error) {
+	return "", fmt.Errorf("TOTPSolver does not support email OTP")
+}
+
+func (s TOTPSolver) SolveCaptcha(ctx context.Context, imageURL string) (string, error) {
+	return "", fmt.Errorf("TOTPSolver does not support captcha")
+}
+
+var emailOTPPattern = regexp.MustCompile(`\b(\d{6})\b`)
+
+// IMAPEmailOTPSolver polls a mailbox via IMAP for LinkedIn's "verify your
+// identity" email and scrapes the 6-digit code out of its subject line.
+// Message bodies are not searched.
+type IMAPEmailOTPSolver struct {
+	Host, Username, Password string
+	Mailbox                  string // defaults to "INBOX"
+	PollInterval             time.Duration
+	Timeout                  time.Duration
+}
+
+func (s IMAPEmailOTPSolver) SolveTOTP(ctx context.Context) (string, error) {
+	return "", fmt.Errorf("IMAPEmailOTPSolver does not support TOTP")
+}
+
+func (s IMAPEmailOTPSolver) SolveCaptcha(ctx context.Context, imageURL string) (string, error) {
+	return "", fmt.Errorf("IMAPEmailOTPSolver does not support captcha")
+}
+
+func (s IMAPEmailOTPSolver) SolveEmailOTP(ctx context.Context) (string, error) {
+	mailbox := s.Mailbox
+	if mailbox == "" {
+		mailbox = "INBOX"
+	}
+	pollInterval := s.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = 10 * time.Second
+	}
+	timeout := s.Timeout
+	if timeout <= 0 {
+		timeout = 2 * time.Minute
+	}
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		default:
+		}
+
+		code, err := s.pollOnce(mailbox)
+		if err == nil && code != "" {
+			return code, nil
+		}
+
+		time.Sleep(pollInterval)
+	}
+
+	return "", fmt.Errorf("no LinkedIn verification email with a code found within %s", timeout)
+}
+
+func (s IMAPEmailOTPSolver) pollOnce(mailbox string) (string, error) {
+	c, err := imapclient.DialTLS(s.Host, nil)
+	if err != nil {
+		return "", fmt.Errorf("dial imap: %w", err)
+	}
+	defer c.Logout()
+
+	if err := c.Login(s.Username, s.Password); err != nil {
+		return "", fmt.Errorf("imap login: %w", err)
+	}
+
+	mbox, err := c.Select(mailbox, false)
+	if err != nil {
+		return "", fmt.Errorf("select mailbox: %w", err)
+	}
+	if mbox.Messages == 0 {
+		return "", fmt.Errorf("mailbox empty")
+	}
+
+	// Fetch the most recent few messages looking for a verification subject.
+	from := uint32(1)
+	if mbox.Messages > 9 {
+		from = mbox.Messages - 9
+	}
+	seqSet := new(imap.SeqSet)
+	seqSet.AddRange(from, mbox.Messages)
+
+	messages := make(chan *imap.Message, 10)
+	fetchErr := make(chan error, 1)
+	go func() {
+		fetchErr <- c.Fetch(seqSet, []imap.FetchItem{imap.FetchEnvelope, imap.FetchBody}, messages)
+	}()
+
+	var code string
+	for msg := range messages {
+		if msg.Envelope == nil {
+			continue
+		}
+		if !strings.Contains(strings.ToLower(msg.Envelope.Subject), "verif") &&
+			!strings.Contains(strings.ToLower(msg.Envelope.Subject), "security code") {
+			continue
+		}
+		if match := emailOTPPattern.FindString(msg.Envelope.Subject); match != "" {
+			code = match
+		}
+	}
+	if err := <-fetchErr; err != nil {
+		return "", fmt.Errorf("imap fetch: %w", err)
+	}
+
+	if code == "" {
+		return "", fmt.Errorf("no verification code found in recent messages")
+	}
+	return code, nil
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fernet/fernet-go"
+)
+
+// SessionCipher encrypts and decrypts the cookie payload before it touches
+// storage.StateStore. Cookies are the entire LinkedIn identity for a session,
+// so the on-disk (or remote) blob must never be plaintext JSON.
+type SessionCipher interface {
+	Encrypt(data []byte) ([]byte, error)
+	Decrypt(data []byte) ([]byte, error)
+}
+
+// NoopCipher passes data through unmodified. Useful for tests and for
+// deployments that accept the risk of plaintext state (e.g. an already
+// encrypted disk).
+type NoopCipher struct{}
+
+func (NoopCipher) Encrypt(data []byte) ([]byte, error) { return data, nil }
+func (NoopCipher) Decrypt(data []byte) ([]byte, error) { return data, nil }
+
+// FernetCipher wraps payloads in Fernet tokens (AES-128-CBC + HMAC-SHA256,
+// authenticated and timestamped) using the github.com/fernet/fernet-go
+// implementation. Keys are ordered newest-first: the first key encrypts,
+// and any key in the slice may decrypt, which is what makes rotation safe -
+// old sessions keep working until their TTL expires even after a new key is
+// pushed out.
+type FernetCipher struct {
+	keys []*fernet.Key
+	ttl  time.Duration
+}
+
+// NewFernetCipher builds a FernetCipher from base64-encoded 32-byte keys
+// (newest-first) and a TTL enforced on decrypt. A zero TTL disables the
+// token age check, so tokens never expire.
+func NewFernetCipher(keysB64 []string, ttl time.Duration) (*FernetCipher, error) {
+	if len(keysB64) == 0 {
+		return nil, fmt.Errorf("fernet cipher requires at least one key")
+	}
+
+	keys := make([]*fernet.Key, 0, len(keysB64))
+	for i, raw := range keysB64 {
+		var k fernet.Key
+		if err := k.Decode(raw); err != nil {
+			return nil, fmt.Errorf("decode fernet key %d: %w", i, err)
+		}
+		keys = append(keys, &k)
+	}
+
+	return &FernetCipher{keys: keys, ttl: ttl}, nil
+}
+
+func (c *FernetCipher) Encrypt(data []byte) ([]byte, error) {
+	// EncryptAndSign always uses the first key, which is why callers must
+	// keep the slice newest-first.
+	token, err := fernet.EncryptAndSign(data, c.keys[0])
+	if err != nil {
+		return nil, fmt.Errorf("fernet encrypt: %w", err)
+	}
+	return token, nil
+}
+
+func (c *FernetCipher) Decrypt(data []byte) ([]byte, error) {
+	plain := fernet.VerifyAndDecrypt(data, c.ttl, c.keys)
+	if plain == nil {
+		return nil, fmt.Errorf("fernet decrypt: invalid or expired token")
+	}
+	return plain, nil
+}
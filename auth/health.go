@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/config"
+)
+
+// healthCheckURL is a lightweight page to validate auth against; it's the
+// same feed document restoreSession already trusts, so no new selectors are
+// needed to interpret the result.
+const healthCheckURL = "https://www.linkedin.com/feed"
+
+// SessionEventType describes a transition a HealthMonitor observed.
+type SessionEventType string
+
+const (
+	// SessionUp means the last health check found the session authenticated.
+	SessionUp SessionEventType = "up"
+	// SessionDown means the last health check found the session logged out
+	// or stuck on a checkpoint the solver couldn't clear.
+	SessionDown SessionEventType = "down"
+	// SessionRefreshing means a re-authentication attempt is underway;
+	// subscribers should pause work against that account until SessionUp.
+	SessionRefreshing SessionEventType = "refreshing"
+)
+
+// SessionEvent is published to HealthMonitor subscribers on every state
+// transition.
+type SessionEvent struct {
+	AccountID string
+	Type      SessionEventType
+	Err       error
+	At        time.Time
+}
+
+// CredentialsLookup resolves the login credentials for accountID so
+// HealthMonitor can re-authenticate without the browser-driven cookie flow
+// having ever stored them itself.
+type CredentialsLookup func(accountID string) (Credentials, bool)
+
+// HealthMonitor periodically re-validates every session in a SessionManager's
+// pool and triggers re-authentication before a queued job discovers the
+// session is dead. Subscribers (posting, scraping) can listen for
+// SessionRefreshing/SessionDown to pause work against the affected account.
+type HealthMonitor struct {
+	manager  *SessionManager
+	cfg      *config.Config
+	log      *zap.SugaredLogger
+	interval time.Duration
+	creds    CredentialsLookup
+
+	mu          sync.Mutex
+	subscribers []chan SessionEvent
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewHealthMonitor builds a HealthMonitor for manager's pool. interval is the
+// polling period between sweeps (defaults to 30 minutes if <= 0). creds may
+// be nil if the deployment relies solely on the OAuth path for re-auth.
+func NewHealthMonitor(manager *SessionManager, cfg *config.Config, log *zap.SugaredLogger, interval time.Duration, creds CredentialsLookup) *HealthMonitor {
+	if interval <= 0 {
+		interval = 30 * time.Minute
+	}
+	return &HealthMonitor{
+		manager:  manager,
+		cfg:      cfg,
+		log:      log,
+		interval: interval,
+		creds:    creds,
+		stop:     make(chan struct{}),
+	}
+}
+
+// Subscribe registers ch to receive SessionEvents. Sends are non-blocking:
+// a subscriber that falls behind simply misses events rather than stalling
+// the monitor.
+func (h *HealthMonitor) Subscribe(ch chan SessionEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subscribers = append(h.subscribers, ch)
+}
+
+// Start begins the periodic health-check sweep in a background goroutine.
+func (h *HealthMonitor) Start() {
+	h.wg.Add(1)
+	go h.run()
+}
+
+// Stop halts the sweep and waits for the current one to finish.
+func (h *HealthMonitor) Stop() {
+	close(h.stop)
+	h.wg.Wait()
+}
+
+func (h *HealthMonitor) run() {
+	defer h.wg.Done()
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stop:
+			return
+		case <-ticker.C:
+			h.sweep()
+		}
+	}
+}
+
+func (h *HealthMonitor) sweep() {
+	for accountID, session := range h.manager.Snapshot() {
+		h.checkAccount(accountID, session)
+	}
+}
+
+func (h *HealthMonitor) checkAccount(accountID string, session *ManagedSession) {
+	sessionLog := h.log.With("accountID", accountID)
+
+	session.Lock()
+	defer session.Unlock()
+
+	if err := session.Page.Navigate(healthCheckURL); err != nil {
+		sessionLog.Warnw("health check navigate failed", "error", err)
+		return
+	}
+
+	ok, err := waitForFeedOrCheckpoint(session.Page, challengeSolverFromConfig(h.cfg), sessionLog)
+	if err == nil && ok {
+		session.LastUsed = time.Now()
+		h.publish(SessionEvent{AccountID: accountID, Type: SessionUp, At: time.Now()})
+		return
+	}
+
+	sessionLog.Warnw("health check detected logged-out session", "error", err)
+	h.publish(SessionEvent{AccountID: accountID, Type: SessionDown, Err: err, At: time.Now()})
+	h.reauthenticate(accountID, session, sessionLog)
+}
+
+// reauthenticate tries, in order, the OAuth token already on file and then
+// stored credentials, so a dead browser session doesn't block queued jobs
+// any longer than the sweep interval.
+func (h *HealthMonitor) reauthenticate(accountID string, session *ManagedSession, log *zap.SugaredLogger) {
+	ctx := context.Background()
+
+	h.publish(SessionEvent{AccountID: accountID, Type: SessionRefreshing, At: time.Now()})
+
+	if h.cfg.OAuth.Enabled && hasUsableOAuthToken(ctx, h.manager.store) {
+		log.Infow("oauth token still usable, skipping credential re-login")
+		h.publish(SessionEvent{AccountID: accountID, Type: SessionUp, At: time.Now()})
+		return
+	}
+
+	if h.creds == nil {
+		log.Warnw("no credentials lookup configured, cannot re-authenticate")
+		return
+	}
+	creds, ok := h.creds(accountID)
+	if !ok {
+		log.Warnw("no credentials available for re-authentication")
+		return
+	}
+
+	if err := performLogin(ctx, session.Page, creds.Email, creds.Password, log, h.cfg); err != nil {
+		log.Warnw("re-authentication failed", "error", err)
+		return
+	}
+	if err := persistSession(ctx, session.Browser, h.manager.store, log, h.manager.cipher); err != nil {
+		log.Warnw("persist session after re-auth failed", "error", err)
+	}
+
+	log.Infow("re-authentication succeeded")
+	h.publish(SessionEvent{AccountID: accountID, Type: SessionUp, At: time.Now()})
+}
+
+func (h *HealthMonitor) publish(evt SessionEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, ch := range h.subscribers {
+		select {
+		case ch <- evt:
+		default:
+		}
+	}
+}
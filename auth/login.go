@@ -25,8 +25,24 @@ var (
 
 // LoginOrRestoreSession restores cookies if present; otherwise performs a fresh login.
 func LoginOrRestoreSession(ctx context.Context, browser *rod.Browser, page *rod.Page, store storage.StateStore, log *zap.SugaredLogger, cfg *config.Config) error {
+	cipher, err := sessionCipherFromConfig(cfg, log)
+	if err != nil {
+		return fmt.Errorf("build session cipher: %w", err)
+	}
+
+	// 0) If OAuth is enabled and a usable access/refresh token is already on
+	// file, prefer it over the browser entirely: LinkedIn's API covers the
+	// read/post operations the OAuth scopes grant, so there's no need to
+	// drive rod+cookies for those. Flows that genuinely need the web UI
+	// (e.g. search/connect automation) still fall through to the cookie
+	// path below when no OAuth token is present.
+	if cfg.OAuth.Enabled && hasUsableOAuthToken(ctx, store) {
+		log.Infow("using stored OAuth token, skipping browser login")
+		return nil
+	}
+
 	// 1) Try restoring session from persisted cookies.
-	ok, err := restoreSession(ctx, browser, page, store, log)
+	ok, err := restoreSession(ctx, browser, page, store, log, cipher, challengeSolverFromConfig(cfg))
 	if err != nil {
 		if errors.Is(err, ErrCheckpoint) {
 			log.Warnw("checkpoint detected during restore", "error", err)
@@ -48,12 +64,44 @@ func LoginOrRestoreSession(ctx context.Context, browser *rod.Browser, page *rod.
 		return err
 	}
 
-	if err := persistSession(ctx, browser, store, log); err != nil {
+	if err := persistSession(ctx, browser, store, log, cipher); err != nil {
 		log.Warnw("persist session failed", "error", err)
 	}
 	return nil
 }
 
+// sessionCipherFromConfig builds the SessionCipher implied by cfg.Security.
+// Absence of configured keys is intentional for local/dev use and falls back
+// to a no-op cipher rather than failing startup.
+func sessionCipherFromConfig(cfg *config.Config, log *zap.SugaredLogger) (SessionCipher, error) {
+	if len(cfg.Security.FernetKeys) == 0 {
+		log.Warn("no security.fernet_keys configured; session cookies will be stored unencrypted")
+		return NoopCipher{}, nil
+	}
+
+	ttl := time.Duration(cfg.Security.SessionTTLMinutes) * time.Minute
+	return NewFernetCipher(cfg.Security.FernetKeys, ttl)
+}
+
+// challengeSolverFromConfig returns the ChallengeSolver implied by
+// cfg.Checkpoint, or nil when no solver is configured (checkpoints then
+// surface as ErrCheckpoint, same as before this feature existed).
+func challengeSolverFromConfig(cfg *config.Config) ChallengeSolver {
+	switch strings.ToLower(cfg.Checkpoint.Solver) {
+	case "totp":
+		return TOTPSolver{Secret: cfg.Checkpoint.TOTPSecret}
+	case "email_otp":
+		return IMAPEmailOTPSolver{
+			Host:     cfg.Checkpoint.IMAPHost,
+			Username: cfg.Checkpoint.IMAPUsername,
+			Password: cfg.Checkpoint.IMAPPassword,
+			Mailbox:  cfg.Checkpoint.IMAPMailbox,
+		}
+	default:
+		return nil
+	}
+}
+
 func loadCredsFromEnv() (string, string, error) {
 	email, ok1 := os.LookupEnv("LINKEDIN_EMAIL")
 	pass, ok2 := os.LookupEnv("LINKEDIN_PASSWORD")
@@ -94,10 +142,10 @@ func performLogin(ctx context.Context, page *rod.Page, email, password string, l
 	if emailEl != nil {
 		val, _ := emailEl.Attribute("value")
 		if val == nil || strings.TrimSpace(*val) == "" {
-			if err := stealth.MoveToElementHuman(page, emailEl, cfg.Timing); err != nil {
+			if err := stealth.MoveToElementHuman(ctx, page, emailEl, cfg.Timing); err != nil {
 				return fmt.Errorf("move to email: %w", err)
 			}
-			if err := stealth.TypeHuman(emailEl, email, cfg.Timing); err != nil {
+			if err := stealth.TypeHuman(ctx, emailEl, email, cfg.Timing); err != nil {
 				return fmt.Errorf("type email: %w", err)
 			}
 		} else {
@@ -105,17 +153,19 @@ func performLogin(ctx context.Context, page *rod.Page, email, password string, l
 		}
 	}
 
-	if err := stealth.MoveToElementHuman(page, passwordEl, cfg.Timing); err != nil {
+	if err := stealth.MoveToElementHuman(ctx, page, passwordEl, cfg.Timing); err != nil {
 		return fmt.Errorf("move to password: %w", err)
 	}
-	if err := stealth.TypeHuman(passwordEl, password, cfg.Timing); err != nil {
+	if err := stealth.TypeHuman(ctx, passwordEl, password, cfg.Timing); err != nil {
 		return fmt.Errorf("type password: %w", err)
 	}
 
-	if err := stealth.MoveToElementHuman(page, loginBtn, cfg.Timing); err != nil {
+	if err := stealth.MoveToElementHuman(ctx, page, loginBtn, cfg.Timing); err != nil {
 		return fmt.Errorf("move to login button: %w", err)
 	}
-	stealth.ShortPause(cfg.Timing)
+	if err := stealth.ShortPause(ctx, cfg.Timing); err != nil {
+		return err
+	}
 	if err := loginBtn.Click(proto.InputMouseButtonLeft, 1); err != nil {
 		return fmt.Errorf("login submit click: %w", err)
 	}
@@ -123,7 +173,7 @@ func performLogin(ctx context.Context, page *rod.Page, email, password string, l
 	// Wait for redirect or errors using DOM/state heuristics (LinkedIn often
 	// keeps you on the same document). Avoid WaitNavigation; explicitly poll
 	// for authenticated markers or checkpoint redirects.
-	ok, err := awaitLoginResult(page)
+	ok, err := awaitLoginResult(page, challengeSolverFromConfig(cfg), log)
 	if err != nil {
 		if errors.Is(err, ErrCheckpoint) {
 			log.Warnw("checkpoint detected after login", "error", err)
@@ -146,7 +196,9 @@ func performLogin(ctx context.Context, page *rod.Page, email, password string, l
 
 // awaitLoginResult waits for either feed elements (success), error indicators
 // (invalid creds), or checkpoint/captcha markers. Returns (success, error).
-func awaitLoginResult(page *rod.Page) (bool, error) {
+// If solver is non-nil, a detected checkpoint is resolved in place rather
+// than immediately failing the login.
+func awaitLoginResult(page *rod.Page, solver ChallengeSolver, log *zap.SugaredLogger) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
 	p := page.Context(ctx)
@@ -177,7 +229,14 @@ func awaitLoginResult(page *rod.Page) (bool, error) {
 			
 			// Check for checkpoint/challenge
 			if strings.Contains(lurl, "checkpoint") || strings.Contains(lurl, "challenge") || strings.Contains(lurl, "captcha") {
-				return false, ErrCheckpoint
+				if solver == nil {
+					return false, ErrCheckpoint
+				}
+				if err := resolveChallenge(ctx, p, solver, log); err != nil {
+					log.Warnw("challenge resolution failed", "error", err)
+					return false, ErrCheckpoint
+				}
+				continue
 			}
 
 			// Check for invalid credentials errors first
@@ -0,0 +1,243 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-rod/rod"
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/config"
+	"linkedin-automation-tool/storage"
+)
+
+// ManagerMetrics receives pool health events from SessionManager so callers
+// can wire them into whatever metrics system they use (logs today, a
+// Prometheus exporter later).
+type ManagerMetrics interface {
+	SessionOpened(accountID string)
+	SessionEvicted(accountID string)
+	RestoreFailed(accountID string, err error)
+}
+
+// noopManagerMetrics discards everything; the default when callers don't
+// care to observe pool health.
+type noopManagerMetrics struct{}
+
+func (noopManagerMetrics) SessionOpened(string)        {}
+func (noopManagerMetrics) SessionEvicted(string)       {}
+func (noopManagerMetrics) RestoreFailed(string, error) {}
+
+// ManagedSession wraps one account's live browser/page pair along with the
+// bookkeeping SessionManager needs to evict it safely.
+type ManagedSession struct {
+	AccountID string
+	Browser   *rod.Browser
+	Page      *rod.Page
+	LastUsed  time.Time
+
+	mu sync.Mutex // serializes navigation on this session's page
+}
+
+// Lock must be held by callers driving Page directly, so two goroutines
+// never interleave navigation on the same tab.
+func (s *ManagedSession) Lock()   { s.mu.Lock() }
+func (s *ManagedSession) Unlock() { s.mu.Unlock() }
+
+// Touch refreshes the idle clock; call it whenever the session is used.
+func (s *ManagedSession) Touch() {
+	s.mu.Lock()
+	s.LastUsed = time.Now()
+	s.mu.Unlock()
+}
+
+// Credentials needed to establish a fresh session for an account.
+type Credentials struct {
+	Email    string
+	Password string
+}
+
+// SessionManager owns a keyed pool of ManagedSessions, one per LinkedIn
+// account, so a single process can safely run several identities. It is the
+// foundation other subsystems (campaigns, scheduler) build on instead of
+// touching cookies/browsers directly.
+type SessionManager struct {
+	store   storage.StateStore
+	cipher  SessionCipher
+	cfg     *config.Config
+	log     *zap.SugaredLogger
+	metrics ManagerMetrics
+
+	idleTTL time.Duration
+
+	mu       sync.RWMutex
+	sessions map[string]*ManagedSession
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewSessionManager builds a SessionManager. idleTTL is how long a session
+// may sit unused before the janitor evicts it (persisting cookies first).
+func NewSessionManager(store storage.StateStore, cipher SessionCipher, cfg *config.Config, log *zap.SugaredLogger, idleTTL time.Duration, metrics ManagerMetrics) *SessionManager {
+	if cipher == nil {
+		cipher = NoopCipher{}
+	}
+	if metrics == nil {
+		metrics = noopManagerMetrics{}
+	}
+	if idleTTL <= 0 {
+		idleTTL = 30 * time.Minute
+	}
+
+	m := &SessionManager{
+		store:    store,
+		cipher:   cipher,
+		cfg:      cfg,
+		log:      log,
+		metrics:  metrics,
+		idleTTL:  idleTTL,
+		sessions: make(map[string]*ManagedSession),
+		stop:     make(chan struct{}),
+	}
+
+	m.wg.Add(1)
+	go m.janitor()
+
+	return m
+}
+
+// Get returns the live session for accountID, if one is open.
+func (m *SessionManager) Get(accountID string) (*ManagedSession, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	s, ok := m.sessions[accountID]
+	return s, ok
+}
+
+// Snapshot returns a shallow copy of the currently pooled sessions, keyed by
+// account ID. Callers (e.g. HealthMonitor) that only read session state
+// should use this instead of reaching into sessions directly.
+func (m *SessionManager) Snapshot() map[string]*ManagedSession {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	out := make(map[string]*ManagedSession, len(m.sessions))
+	for accountID, session := range m.sessions {
+		out[accountID] = session
+	}
+	return out
+}
+
+// New opens (or returns the existing) session for accountID, restoring
+// cookies when possible and falling back to a fresh login with creds.
+func (m *SessionManager) New(ctx context.Context, accountID string, browser *rod.Browser, page *rod.Page, creds Credentials) (*ManagedSession, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if existing, ok := m.sessions[accountID]; ok {
+		existing.Touch()
+		return existing, nil
+	}
+
+	sessionLog := m.log.With("accountID", accountID)
+
+	restored, err := restoreSession(ctx, browser, page, m.store, sessionLog, m.cipher, challengeSolverFromConfig(m.cfg))
+	if err != nil {
+		m.metrics.RestoreFailed(accountID, err)
+		sessionLog.Warnw("session restore failed, will attempt fresh login", "error", err)
+	}
+
+	if !restored {
+		if err := performLogin(ctx, page, creds.Email, creds.Password, sessionLog, m.cfg); err != nil {
+			return nil, fmt.Errorf("login account %s: %w", accountID, err)
+		}
+		if err := persistSession(ctx, browser, m.store, sessionLog, m.cipher); err != nil {
+			sessionLog.Warnw("persist session failed", "error", err)
+		}
+	}
+
+	session := &ManagedSession{
+		AccountID: accountID,
+		Browser:   browser,
+		Page:      page,
+		LastUsed:  time.Now(),
+	}
+	m.sessions[accountID] = session
+	m.metrics.SessionOpened(accountID)
+
+	return session, nil
+}
+
+// Close persists cookies for accountID and removes it from the pool,
+// closing the underlying browser.
+func (m *SessionManager) Close(ctx context.Context, accountID string) error {
+	m.mu.Lock()
+	session, ok := m.sessions[accountID]
+	if ok {
+		delete(m.sessions, accountID)
+	}
+	m.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	sessionLog := m.log.With("accountID", accountID)
+	if err := persistSession(ctx, session.Browser, m.store, sessionLog, m.cipher); err != nil {
+		sessionLog.Warnw("persist session on close failed", "error", err)
+	}
+
+	m.metrics.SessionEvicted(accountID)
+	return session.Browser.Close()
+}
+
+// Shutdown stops the janitor goroutine. It does not close live sessions;
+// callers should Close each account explicitly first.
+func (m *SessionManager) Shutdown() {
+	close(m.stop)
+	m.wg.Wait()
+}
+
+// janitor periodically evicts sessions idle for longer than idleTTL.
+func (m *SessionManager) janitor() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.idleTTL / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+			m.evictIdle()
+		}
+	}
+}
+
+func (m *SessionManager) evictIdle() {
+	var stale []string
+
+	m.mu.RLock()
+	now := time.Now()
+	for accountID, session := range m.sessions {
+		session.mu.Lock()
+		idle := now.Sub(session.LastUsed)
+		session.mu.Unlock()
+		if idle >= m.idleTTL {
+			stale = append(stale, accountID)
+		}
+	}
+	m.mu.RUnlock()
+
+	for _, accountID := range stale {
+		if err := m.Close(context.Background(), accountID); err != nil {
+			m.log.Warnw("janitor eviction failed", "accountID", accountID, "error", err)
+		} else {
+			m.log.Infow("janitor evicted idle session", "accountID", accountID, "idleTTL", m.idleTTL)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"linkedin-automation-tool/config"
+	"linkedin-automation-tool/storage"
+)
+
+const oauthTokenKey = "linkedin_oauth_token"
+
+// linkedInEndpoint are LinkedIn's OAuth2 "Sign in with LinkedIn" endpoints.
+var linkedInEndpoint = oauth2.Endpoint{
+	AuthURL:  "https://www.linkedin.com/oauth/v2/authorization",
+	TokenURL: "https://www.linkedin.com/oauth/v2/accessToken",
+}
+
+// NewOAuthConfig builds the oauth2.Config for LinkedIn from cfg.OAuth.
+func NewOAuthConfig(cfg *config.Config) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     cfg.OAuth.ClientID,
+		ClientSecret: cfg.OAuth.ClientSecret,
+		RedirectURL:  cfg.OAuth.RedirectURL,
+		Scopes:       cfg.OAuth.Scopes,
+		Endpoint:     linkedInEndpoint,
+	}
+}
+
+// AuthorizationURL returns the URL the user visits to grant access, with a
+// caller-supplied CSRF state value.
+func AuthorizationURL(oauthCfg *oauth2.Config, state string) string {
+	return oauthCfg.AuthCodeURL(state, oauth2.AccessTypeOffline)
+}
+
+// ExchangeCode exchanges an authorization code for a token and persists it.
+func ExchangeCode(ctx context.Context, oauthCfg *oauth2.Config, store storage.StateStore, code string) (*oauth2.Token, error) {
+	tok, err := oauthCfg.Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("exchange code: %w", err)
+	}
+	if err := saveOAuthToken(ctx, store, tok); err != nil {
+		return nil, err
+	}
+	return tok, nil
+}
+
+func loadOAuthToken(ctx context.Context, store storage.StateStore) (*oauth2.Token, error) {
+	raw, err := store.Load(ctx, oauthTokenKey)
+	if err != nil {
+		return nil, err
+	}
+	var tok oauth2.Token
+	if err := json.Unmarshal(raw, &tok); err != nil {
+		return nil, fmt.Errorf("parse oauth token: %w", err)
+	}
+	return &tok, nil
+}
+
+func saveOAuthToken(ctx context.Context, store storage.StateStore, tok *oauth2.Token) error {
+	raw, err := json.Marshal(tok)
+	if err != nil {
+		return fmt.Errorf("marshal oauth token: %w", err)
+	}
+	if err := store.Save(ctx, oauthTokenKey, raw); err != nil {
+		return fmt.Errorf("save oauth token: %w", err)
+	}
+	return nil
+}
+
+// persistingTokenSource wraps an oauth2.TokenSource and writes the token
+// back to storage whenever it changes (i.e. was refreshed), so a refresh
+// performed mid-run survives process restarts.
+type persistingTokenSource struct {
+	ctx    context.Context
+	store  storage.StateStore
+	log    *zap.SugaredLogger
+	source oauth2.TokenSource
+	last   string
+}
+
+func (p *persistingTokenSource) Token() (*oauth2.Token, error) {
+	tok, err := p.source.Token()
+	if err != nil {
+		return nil, err
+	}
+	if tok.AccessToken != p.last {
+		p.last = tok.AccessToken
+		if err := saveOAuthToken(p.ctx, p.store, tok); err != nil {
+			p.log.Warnw("persist refreshed oauth token failed", "error", err)
+		}
+	}
+	return tok, nil
+}
+
+// OAuthClient returns an *http.Client authenticated via a stored LinkedIn
+// OAuth token, transparently refreshing (and persisting the refresh) when
+// the access token has expired. It does not touch the browser/rod at all;
+// it is for the subset of operations LinkedIn's API supports directly.
+func OAuthClient(ctx context.Context, store storage.StateStore, oauthCfg *oauth2.Config, log *zap.SugaredLogger) (*http.Client, error) {
+	tok, err := loadOAuthToken(ctx, store)
+	if err != nil {
+		return nil, fmt.Errorf("load oauth token: %w", err)
+	}
+
+	src := &persistingTokenSource{
+		ctx:    ctx,
+		store:  store,
+		log:    log,
+		source: oauthCfg.TokenSource(ctx, tok),
+		last:   tok.AccessToken,
+	}
+
+	return oauth2.NewClient(ctx, src), nil
+}
+
+// hasUsableOAuthToken reports whether a stored OAuth token exists and can
+// still authenticate (valid, or refreshable via its refresh token).
+func hasUsableOAuthToken(ctx context.Context, store storage.StateStore) bool {
+	tok, err := loadOAuthToken(ctx, store)
+	if err != nil {
+		return false
+	}
+	return tok.Valid() || tok.RefreshToken != ""
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"sort"
+	"sync"
+)
+
+// selectorRanker tracks how often each selector in a polling loop is the one
+// that actually matched, so the loop can try the most reliable selector
+// first instead of walking the full list (and its per-selector timeout)
+// every tick. LinkedIn periodically removes selectors from the DOM; a
+// selector that never hits sinks to the back without needing a code change.
+type selectorRanker struct {
+	mu    sync.Mutex
+	order []string
+	hits  map[string]int
+}
+
+func newSelectorRanker(initial []string) *selectorRanker {
+	order := make([]string, len(initial))
+	copy(order, initial)
+	return &selectorRanker{
+		order: order,
+		hits:  make(map[string]int, len(initial)),
+	}
+}
+
+// Selectors returns the current selector order, most-reliable first.
+func (r *selectorRanker) Selectors() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make([]string, len(r.order))
+	copy(out, r.order)
+	return out
+}
+
+// RecordHit bumps sel's hit count and re-sorts so it moves toward the front.
+func (r *selectorRanker) RecordHit(sel string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.hits[sel]++
+	sort.SliceStable(r.order, func(i, j int) bool {
+		return r.hits[r.order[i]] > r.hits[r.order[j]]
+	})
+}
@@ -17,9 +17,22 @@ import (
 
 const sessionKey = "linkedin_session"
 
+// authSelectorRanker orders the feed/checkpoint auth selectors by observed
+// hit rate so waitForFeedOrCheckpoint tries the most reliable one first.
+// Shared across calls (and accounts) since the DOM selectors LinkedIn
+// serves don't vary per account.
+var authSelectorRanker = newSelectorRanker([]string{
+	"main.scaffold-layout__main",   // Main content area
+	"nav.global-nav",               // Global navigation
+	"img.global-nav__me-photo",     // Profile photo in nav
+	"button[aria-label*='Me']",     // Me button
+	"div.feed-shared-update-v2",    // Feed post
+	"aside.scaffold-layout__aside", // Sidebar
+})
+
 // restoreSession attempts to load cookies from the StateStore and validate
 // whether the session is still usable by navigating to the feed.
-func restoreSession(ctx context.Context, browser *rod.Browser, page *rod.Page, store storage.StateStore, log *zap.SugaredLogger) (bool, error) {
+func restoreSession(ctx context.Context, browser *rod.Browser, page *rod.Page, store storage.StateStore, log *zap.SugaredLogger, cipher SessionCipher, solver ChallengeSolver) (bool, error) {
 	raw, err := store.Load(ctx, sessionKey)
 	if err != nil {
 		// Absence is fine; surface other failures.
@@ -29,8 +42,16 @@ func restoreSession(ctx context.Context, browser *rod.Browser, page *rod.Page, s
 		return false, nil
 	}
 
+	plain, err := cipher.Decrypt(raw)
+	if err != nil {
+		// Treat a bad or expired token the same as "no session": fall
+		// through to a fresh login rather than failing the whole run.
+		log.Warnw("session decrypt failed, treating as absent", "error", err)
+		return false, nil
+	}
+
 	var cookies []*proto.NetworkCookie
-	if err := json.Unmarshal(raw, &cookies); err != nil {
+	if err := json.Unmarshal(plain, &cookies); err != nil {
 		log.Warnw("session parse failed", "error", err)
 		return false, nil
 	}
@@ -46,7 +67,7 @@ func restoreSession(ctx context.Context, browser *rod.Browser, page *rod.Page, s
 		return false, fmt.Errorf("navigate feed during restore: %w", err)
 	}
 
-	ok, err := waitForFeedOrCheckpoint(page)
+	ok, err := waitForFeedOrCheckpoint(page, solver, log)
 	if err != nil {
 		return false, err
 	}
@@ -60,7 +81,7 @@ func restoreSession(ctx context.Context, browser *rod.Browser, page *rod.Page, s
 }
 
 // persistSession captures current cookies and saves them for reuse.
-func persistSession(ctx context.Context, browser *rod.Browser, store storage.StateStore, log *zap.SugaredLogger) error {
+func persistSession(ctx context.Context, browser *rod.Browser, store storage.StateStore, log *zap.SugaredLogger, cipher SessionCipher) error {
 	cookies, err := browser.GetCookies()
 	if err != nil {
 		return fmt.Errorf("get cookies: %w", err)
@@ -71,7 +92,12 @@ func persistSession(ctx context.Context, browser *rod.Browser, store storage.Sta
 		return fmt.Errorf("marshal cookies: %w", err)
 	}
 
-	if err := store.Save(ctx, sessionKey, payload); err != nil {
+	sealed, err := cipher.Encrypt(payload)
+	if err != nil {
+		return fmt.Errorf("encrypt session: %w", err)
+	}
+
+	if err := store.Save(ctx, sessionKey, sealed); err != nil {
 		return fmt.Errorf("save session: %w", err)
 	}
 
@@ -102,8 +128,11 @@ func toCookieParams(cs []*proto.NetworkCookie) []*proto.NetworkCookieParam {
 
 // waitForFeedOrCheckpoint waits briefly to determine whether we landed on feed,
 // got bounced to login, or hit a checkpoint page. We avoid aggressive retries
-// to respect LinkedIn's security layers.
-func waitForFeedOrCheckpoint(page *rod.Page) (bool, error) {
+// to respect LinkedIn's security layers. If solver is non-nil and a checkpoint
+// is detected, it attempts to resolve the challenge and keeps polling instead
+// of immediately surfacing ErrCheckpoint; a poll that still finds a checkpoint
+// URL runs the solver again.
+func waitForFeedOrCheckpoint(page *rod.Page, solver ChallengeSolver, log *zap.SugaredLogger) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
 	p := page.Context(ctx)
@@ -118,16 +147,6 @@ func waitForFeedOrCheckpoint(page *rod.Page) (bool, error) {
 	// Poll for authentication indicators
 	ticker := time.NewTicker(500 * time.Millisecond)
 	defer ticker.Stop()
-	
-	// Multiple selectors for authenticated state (LinkedIn DOM changes frequently)
-	authSelectors := []string{
-		"main.scaffold-layout__main",           // Main content area
-		"nav.global-nav",                       // Global navigation
-		"img.global-nav__me-photo",             // Profile photo in nav
-		"button[aria-label*='Me']",             // Me button
-		"div.feed-shared-update-v2",            // Feed post
-		"aside.scaffold-layout__aside",         // Sidebar
-	}
 
 	for {
 		select {
@@ -142,14 +161,26 @@ func waitForFeedOrCheckpoint(page *rod.Page) (bool, error) {
 			
 			// Check for checkpoint/challenge
 			if strings.Contains(url, "/checkpoint/") || strings.Contains(url, "captcha") || strings.Contains(url, "/challenge/") {
-				return false, ErrCheckpoint
+				if solver == nil {
+					return false, ErrCheckpoint
+				}
+				if err := resolveChallenge(ctx, p, solver, log); err != nil {
+					log.Warnw("challenge resolution failed", "error", err)
+					return false, ErrCheckpoint
+				}
+				// Give LinkedIn a moment to process the submitted code
+				// before the next poll tick re-checks the URL.
+				continue
 			}
 
+			authSelectors := authSelectorRanker.Selectors()
+
 			// Check if on feed page
 			if strings.Contains(url, "/feed") {
 				// URL says feed, but verify content is actually there
 				for _, sel := range authSelectors {
 					if _, err := p.Timeout(2 * time.Second).Element(sel); err == nil {
+						authSelectorRanker.RecordHit(sel)
 						return true, nil
 					}
 				}
@@ -160,6 +191,7 @@ func waitForFeedOrCheckpoint(page *rod.Page) (bool, error) {
 			// Not on feed URL, check for authenticated elements
 			for _, sel := range authSelectors {
 				if _, err := p.Timeout(2 * time.Second).Element(sel); err == nil {
+					authSelectorRanker.RecordHit(sel)
 					return true, nil
 				}
 			}
@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"linkedin-automation-tool/storage"
+)
+
+// SessionTicket lets multiple LinkedIn accounts share one storage.StateStore
+// (in particular a shared storage.RedisStateStore) under distinct,
+// unguessable keys. Only SessionID is ever used as the store key; Secret
+// encrypts the payload, so a stolen Redis dump does not by itself let an
+// attacker resume the session.
+type SessionTicket struct {
+	CookieName string `json:"cookie_name"`
+	SessionID  string `json:"session_id"`
+	Secret     string `json:"secret"` // base64-encoded, never persisted alongside the payload
+}
+
+// NewSessionTicket generates a fresh ticket for cookieName with a random
+// session ID and a random 32-byte secret.
+func NewSessionTicket(cookieName string) (*SessionTicket, error) {
+	sessionID, err := randomToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("generate session id: %w", err)
+	}
+	secret, err := randomToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("generate secret: %w", err)
+	}
+
+	return &SessionTicket{
+		CookieName: cookieName,
+		SessionID:  sessionID,
+		Secret:     secret,
+	}, nil
+}
+
+// StoreKey is the Redis/StateStore key this ticket's payload lives under.
+func (t *SessionTicket) StoreKey() string {
+	return "ticket:" + t.SessionID
+}
+
+func (t *SessionTicket) gcm() (cipher.AEAD, error) {
+	secret, err := base64.RawURLEncoding.DecodeString(t.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("decode ticket secret: %w", err)
+	}
+	// Derive a fixed 32-byte AES-256 key regardless of the raw secret length.
+	key := sha256.Sum256(secret)
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("new aes cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// Seal encrypts payload (AES-GCM) for storage under t.StoreKey().
+func (t *SessionTicket) Seal(payload []byte) ([]byte, error) {
+	aead, err := t.gcm()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, payload, nil), nil
+}
+
+// Open decrypts a payload previously produced by Seal.
+func (t *SessionTicket) Open(sealed []byte) ([]byte, error) {
+	aead, err := t.gcm()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("sealed ticket payload too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+
+	plain, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open sealed ticket: %w", err)
+	}
+	return plain, nil
+}
+
+// SaveCookies encrypts and stores payload under the ticket's key in store.
+func (t *SessionTicket) SaveCookies(ctx context.Context, store storage.StateStore, payload []byte) error {
+	sealed, err := t.Seal(payload)
+	if err != nil {
+		return err
+	}
+	return store.Save(ctx, t.StoreKey(), sealed)
+}
+
+// LoadCookies loads and decrypts the ticket's payload from store.
+func (t *SessionTicket) LoadCookies(ctx context.Context, store storage.StateStore) ([]byte, error) {
+	sealed, err := store.Load(ctx, t.StoreKey())
+	if err != nil {
+		return nil, err
+	}
+	return t.Open(sealed)
+}
+
+func randomToken(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -4,14 +4,13 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/binary"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"math"
 	"math/big"
 	mathrand "math/rand"
-	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
 	"github.com/go-rod/rod"
@@ -20,78 +19,95 @@ import (
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
 
+	"linkedin-automation-tool/agent"
+	"linkedin-automation-tool/app"
 	"linkedin-automation-tool/auth"
 	"linkedin-automation-tool/config"
-	"linkedin-automation-tool/connect"
+	"linkedin-automation-tool/control"
 	"linkedin-automation-tool/logger"
-	"linkedin-automation-tool/messaging"
+	"linkedin-automation-tool/metrics"
 	"linkedin-automation-tool/navigation"
-	"linkedin-automation-tool/search"
 	"linkedin-automation-tool/stealth"
 	"linkedin-automation-tool/storage"
+	"linkedin-automation-tool/tui"
+)
+
+// Daily send limits, kept conservative to avoid LinkedIn restrictions.
+// Shared between the agent's default task params and the metrics quota
+// gauge so both agree on what "remaining" means.
+const (
+	maxConnectionsPerDay = agent.MaxConnectionsPerDay
+	maxMessagesPerDay    = agent.MaxMessagesPerDay
 )
 
 func main() {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	// Trap interrupts to exit cleanly and close the browser.
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
-	go func() {
-		<-sigCh
-		cancel()
-	}()
+	tuiMode := flag.Bool("tui", false, "launch the Bubble Tea dashboard instead of running headless")
+	randSeed := flag.Int64("rand-seed", 0, "seed the stealth package's randomness (and pacing.rand_seed) for reproducible runs (0 = time-based, non-reproducible)")
+	flag.Parse()
+
+	if *randSeed != 0 {
+		stealth.SetRandomizer(stealth.WithSeed(*randSeed))
+	}
 
 	cfg, err := config.Load("./config.yaml")
 	if err != nil {
 		log.Fatalf("config: %v", err)
 	}
+	if *randSeed != 0 {
+		cfg.Pacing.RandSeed = *randSeed
+	}
 
 	// Load optional .env to ease local development (non-production, educational).
 	_ = godotenv.Load()
 
-	zapLogger, err := logger.New(cfg.Logging.Level)
+	var logSink *tui.LogSink
+	var zapLogger *zap.Logger
+	if *tuiMode {
+		// Tee logs into the dashboard's log panel in addition to stdout, so
+		// output from the agent and its tasks shows up there too.
+		logSink = tui.NewLogSink(500)
+		zapLogger, err = logger.NewTee(cfg.Logging.Level, logSink)
+	} else {
+		zapLogger, err = logger.New(cfg.Logging.Level)
+	}
 	if err != nil {
 		log.Fatalf("logger: %v", err)
 	}
 	defer zapLogger.Sync()
 	logr := zapLogger.Sugar()
 
+	// Cancelled on SIGINT/SIGTERM/SIGHUP; every timeout further down the
+	// call chain (including stealth.TypeHuman's per-keystroke context)
+	// derives from this, so Ctrl-C aborts an in-progress action instead of
+	// letting it finish.
+	ctx := app.NewGracefulContext(zapLogger)
+
+	status := app.NewStatus()
+
 	width, height := randomViewport(cfg.Browser.MinViewport, cfg.Browser.MaxViewport)
 
 	ua := cfg.Browser.UserAgents[randomInt(len(cfg.Browser.UserAgents))]
 
-	launchURL, err := launcher.New().
-		// If a specific browser binary is provided, use it (helps in pinned Chrome revisions).
-		Bin(cfg.Browser.Bin).
-		// Disable leakless wrapper on Windows AV-sensitive environments.
-		Leakless(false).
-		// Reduce easily detectable automation switches.
-		// These flags avoid exposing Chrome's automation bits often probed by bot defenses.
-		Headless(cfg.Browser.Headless).
-		Set("disable-blink-features", "AutomationControlled").
-		Set("disable-features", "IsolateOrigins,site-per-process").
-		Set("disable-extensions").
-		Set("disable-component-update").
-		Set("disable-client-side-phishing-detection").
-		Set("window-size", fmt.Sprintf("%d,%d", width, height)).
-		Set("user-agent", ua).
-		Launch()
+	controlURL, err := resolveControlURL(cfg.Browser, width, height, ua)
 	if err != nil {
-		logr.Fatalf("launch browser: %v", err)
+		logr.Fatalf("resolve browser control URL: %v", err)
 	}
 
 	browser := rod.New().
-		ControlURL(launchURL)
+		ControlURL(controlURL)
 		// Don't set a global timeout - let individual operations handle their own timeouts
 
 	if err := browser.Connect(); err != nil {
 		logr.Fatalf("connect browser: %v", err)
 	}
-	defer func() {
-		_ = browser.Close()
-	}()
+	if cfg.Browser.Mode != "connect" {
+		// Only close a browser we launched ourselves; "connect" mode attaches
+		// to someone else's sidecar/manually-launched Chrome, which should
+		// keep running after this process exits.
+		defer func() {
+			_ = browser.Close()
+		}()
+	}
 
 	page, err := browser.Page(proto.TargetCreateTarget{URL: "about:blank"})
 	if err != nil {
@@ -114,10 +130,33 @@ func main() {
 		logr.Fatalf("apply stealth: %v", err)
 	}
 
-	store := &storage.FileStore{BaseDir: "data"}
+	store, err := buildStateStore(cfg.SessionStore)
+	if err != nil {
+		logr.Fatalf("build state store: %v", err)
+	}
+
+	var metricsCollector *metrics.Collector
+	if cfg.Metrics.Enabled {
+		metricsCollector = metrics.New(store, metrics.QuotaLimits{
+			MaxConnectionsPerDay: maxConnectionsPerDay,
+			MaxMessagesPerDay:    maxMessagesPerDay,
+		}, logr)
+		metrics.Serve(ctx, cfg.Metrics.Listen, metricsCollector, logr)
+	}
+
+	if cfg.Admin.Enabled {
+		app.Serve(ctx, cfg.Admin.Listen, status, metricsCollector, logr)
+		go app.MonitorBrowser(ctx, browser, status, 30*time.Second, logr)
+		go app.MonitorQuotaIntegrity(ctx, store, status, 5*time.Minute, logr)
+	}
+
 	if err := auth.LoginOrRestoreSession(ctx, browser, page, store, logr, cfg); err != nil {
+		if errors.Is(err, auth.ErrCheckpoint) {
+			status.SetUnhealthy("checkpoint", err)
+		}
 		logr.Fatalw("auth failed", "error", err)
 	}
+	status.MarkReady()
 
 	logr.Infow("browser ready",
 		"userAgent", ua,
@@ -128,7 +167,10 @@ func main() {
 
 	// Wait 5 seconds after successful login
 	logr.Info("waiting 5 seconds before starting automation...")
-	time.Sleep(5 * time.Second)
+	if err := stealth.SleepCtx(ctx, 5*time.Second); err != nil {
+		logr.Infow("shutdown requested during startup pause", "error", err)
+		return
+	}
 
 	// Create a custom navigation sequence with 5-second pauses
 	logr.Info("starting tab navigation with 5-second intervals...")
@@ -171,154 +213,114 @@ func main() {
 		Tabs:        customTabs,
 	}
 
-	if err := navigation.ExecutePattern(page, customPattern, cfg.Timing, logr); err != nil {
+	if err := navigation.ExecutePattern(ctx, page, customPattern, cfg.Timing, metricsCollector, logr); err != nil {
 		logr.Warnw("navigation pattern failed", "error", err)
 	} else {
 		logr.Info("navigation pattern completed successfully")
 	}
 
-	// Execute people search after navigation
-	logr.Info("🔍 starting LinkedIn people search...")
-	profiles := runPeopleSearch(page, store, *cfg, logr)
+	// Hand off to the agent: it owns the page from here on, dispatching
+	// search/connect/message/navigate as scheduled in cfg.Schedules instead
+	// of running them once in a fixed sequence.
+	a := agent.NewAgent(page, store, *cfg, metricsCollector, logr)
+	a.RegisterTask("search", agent.NewSearchTask(logr))
+	a.RegisterTask("connect", agent.NewConnectTask(logr))
+	a.RegisterTask("message", agent.NewMessageTask(logr))
+	a.RegisterTask("navigate", agent.NewNavigatePatternTask(logr))
+
+	a.MarkReady()
+	a.Start(ctx)
+
+	quotaLimits := metrics.QuotaLimits{
+		MaxConnectionsPerDay: maxConnectionsPerDay,
+		MaxMessagesPerDay:    maxMessagesPerDay,
+	}
 
-	// Send connection requests if profiles were found
-	if len(profiles) > 0 {
-		logr.Info("🤝 starting connection request automation...")
-		runConnectionRequests(page, store, profiles, *cfg, logr)
+	if *tuiMode {
+		if err := tui.Run(ctx, a, store, quotaLimits, logSink, logr); err != nil {
+			logr.Errorw("tui exited with error", "error", err)
+		}
 	} else {
-		logr.Info("⏭️  skipping connection requests (no profiles found)")
+		if cfg.Control.Enabled {
+			control.Serve(ctx, cfg.Control.Listen, a, store, quotaLimits, logr)
+		}
+		logr.Infow("agent started", "schedules", len(cfg.Schedules))
+		<-ctx.Done()
 	}
 
-	// Send follow-up messages to accepted connections
-	logr.Info("💬 starting follow-up messaging...")
-	runFollowUpMessaging(page, store, profiles, *cfg, logr)
-
-	<-ctx.Done()
-	logr.Info("shutdown requested, exiting")
+	logr.Info("shutdown requested, stopping agent")
+	a.Stop()
+	logr.Info("agent stopped, exiting")
 }
 
-// runPeopleSearch executes a LinkedIn people search and saves results
-func runPeopleSearch(page *rod.Page, store storage.StateStore, cfg config.Config, log *zap.SugaredLogger) []string {
-	// Configure search parameters
-	// Modify these parameters based on your search needs
-	params := search.SearchParams{
-		Keywords: "software engineer",
-		Location: "India",
-		MaxPages: 3, // Search first 3 pages (~30 profiles)
-	}
-
-	log.Infow("executing people search",
-		"keywords", params.Keywords,
-		"location", params.Location,
-		"maxPages", params.MaxPages,
-	)
-
-	// Execute search
-	profiles, err := search.FindPeople(
-		context.Background(),
-		page,
-		store,
-		params,
-		cfg.Timing,
-		log,
-	)
-
+// buildStateStore constructs the StateStore implied by cfg, defaulting to
+// the single-tenant on-disk store used today. When cfg.Encrypt is set, the
+// chosen backend is wrapped so cookies, quota counters, and connection-
+// request logs are AES-GCM-encrypted before they ever reach disk, Redis, or S3.
+func buildStateStore(cfg config.SessionStoreConfig) (storage.StateStore, error) {
+	store, err := buildBackendStore(cfg)
 	if err != nil {
-		log.Errorf("❌ Search failed: %v", err)
-		return nil
+		return nil, err
 	}
-
-	log.Infof("✅ Found %d profiles", len(profiles))
-
-	// Save results to file
-	filename := "data/search_results.txt"
-	if err := saveSearchResults(profiles, filename); err != nil {
-		log.Errorf("❌ Failed to save results: %v", err)
-		return profiles
+	if !cfg.Encrypt {
+		return store, nil
 	}
-
-	log.Infof("💾 Results saved to %s", filename)
-
-	// Display first 10 results
-	displayCount := 10
-	if len(profiles) < displayCount {
-		displayCount = len(profiles)
-	}
-
-	log.Infof("📋 First %d profiles:", displayCount)
-	for i := 0; i < displayCount; i++ {
-		log.Infof("  %d. %s", i+1, profiles[i])
-	}
-
-	if len(profiles) > displayCount {
-		log.Infof("  ... and %d more (see %s)", len(profiles)-displayCount, filename)
-	}
-
-	return profiles
+	return storage.NewEncryptedStore(store)
 }
 
-// runConnectionRequests sends connection requests to found profiles
-func runConnectionRequests(page *rod.Page, store storage.StateStore, profiles []string, cfg config.Config, log *zap.SugaredLogger) {
-	// Configure connection request settings
-	// Start conservative to avoid LinkedIn restrictions
-	connectCfg := connect.RequestConfig{
-		MaxPerDay:            10,   // Conservative daily limit (10-20 is safe for most accounts)
-		UsePersonalizedNotes: true, // Send requests with personalized notes using human-like typing
-		NoteTemplate:         "Hi {{name}}, I came across your profile and would love to connect with you. Looking forward to staying in touch!",
-		WaitBetweenRequests:  8000, // 8 seconds minimum wait between requests (longer for note typing)
+func buildBackendStore(cfg config.SessionStoreConfig) (storage.StateStore, error) {
+	switch cfg.Backend {
+	case "redis":
+		return storage.NewRedisStateStore(storage.RedisConfig{
+			Addr:          cfg.RedisURL,
+			SentinelAddrs: cfg.RedisSentinelAddrs,
+			MasterName:    cfg.RedisMasterName,
+			KeyPrefix:     "linkedin:",
+			TTL:           time.Duration(cfg.RedisTTLMinutes) * time.Minute,
+		})
+	case "s3":
+		return storage.NewS3Store(context.Background(), storage.S3Config{
+			Bucket:      cfg.S3Bucket,
+			Region:      cfg.S3Region,
+			Prefix:      "linkedin/",
+			SSEKMSKeyID: cfg.S3SSEKMSKeyID,
+		})
+	default:
+		return &storage.FileStore{BaseDir: "data"}, nil
 	}
-
-	log.Infow("connection request configuration",
-		"maxPerDay", connectCfg.MaxPerDay,
-		"usePersonalizedNotes", connectCfg.UsePersonalizedNotes,
-		"waitBetweenRequests", connectCfg.WaitBetweenRequests,
-	)
-
-	// Execute connection requests
-	err := connect.SendRequests(
-		context.Background(),
-		page,
-		profiles,
-		store,
-		connectCfg,
-		cfg.Timing,
-		log,
-	)
-
-	if err != nil {
-		log.Errorf("❌ Connection requests failed: %v", err)
-		return
-	}
-
-	log.Info("✅ Connection request automation completed")
 }
 
-// saveSearchResults saves profile URLs to a text file
-func saveSearchResults(profiles []string, filename string) error {
-	file, err := os.Create(filename)
-	if err != nil {
-		return fmt.Errorf("create file: %w", err)
+// resolveControlURL returns the CDP WebSocket URL rod should connect to,
+// per cfg.Mode: "connect" attaches directly to cfg.WSEndpoint (a sidecar or
+// manually-launched Chrome); "launch" and "persistent" start cfg.Bin,
+// the latter against cfg.UserDataDir so cookies and "remember me" state
+// survive process restarts.
+func resolveControlURL(cfg config.BrowserConfig, width, height int, ua string) (string, error) {
+	if cfg.Mode == "connect" {
+		return cfg.WSEndpoint, nil
 	}
-	defer file.Close()
 
-	// Write header
-	header := fmt.Sprintf("# LinkedIn People Search Results\n# Generated: %s\n# Total Profiles: %d\n\n",
-		time.Now().Format("2006-01-02 15:04:05"),
-		len(profiles),
-	)
-	if _, err := file.WriteString(header); err != nil {
-		return fmt.Errorf("write header: %w", err)
-	}
+	l := launcher.New().
+		// If a specific browser binary is provided, use it (helps in pinned Chrome revisions).
+		Bin(cfg.Bin).
+		// Disable leakless wrapper on Windows AV-sensitive environments.
+		Leakless(false).
+		// Reduce easily detectable automation switches.
+		// These flags avoid exposing Chrome's automation bits often probed by bot defenses.
+		Headless(cfg.Headless).
+		Set("disable-blink-features", "AutomationControlled").
+		Set("disable-features", "IsolateOrigins,site-per-process").
+		Set("disable-extensions").
+		Set("disable-component-update").
+		Set("disable-client-side-phishing-detection").
+		Set("window-size", fmt.Sprintf("%d,%d", width, height)).
+		Set("user-agent", ua)
 
-	// Write profile URLs
-	for i, url := range profiles {
-		line := fmt.Sprintf("%d. %s\n", i+1, url)
-		if _, err := file.WriteString(line); err != nil {
-			return fmt.Errorf("write url: %w", err)
-		}
+	if cfg.Mode == "persistent" {
+		l = l.UserDataDir(cfg.UserDataDir)
 	}
 
-	return nil
+	return l.Launch()
 }
 
 func randomViewport(min, max int) (int, int) {
@@ -356,42 +358,3 @@ func randomInt(limit int) int {
 	mathrand.Seed(time.Now().UnixNano())
 	return mathrand.Intn(limit)
 }
-
-// runFollowUpMessaging sends follow-up messages to accepted connections
-func runFollowUpMessaging(page *rod.Page, store storage.StateStore, profiles []string, cfg config.Config, log *zap.SugaredLogger) {
-	if len(profiles) == 0 {
-		log.Info("⏭️  no profiles to message")
-		return
-	}
-
-	// Configure messaging settings
-	msgCfg := messaging.FollowUpConfig{
-		MaxPerDay:           5,     // Conservative: 5 messages per day
-		MessageTemplate:     "Hi {{name}}, thanks for connecting! I came across your profile and thought we might have some interesting synergies. Looking forward to staying in touch!",
-		WaitBetweenMessages: 15000, // 15 seconds between messages
-		Context:             "software engineering", // Context for {{context}} variable
-	}
-
-	log.Infow("follow-up messaging configuration",
-		"maxPerDay", msgCfg.MaxPerDay,
-		"waitBetweenMessages", msgCfg.WaitBetweenMessages,
-	)
-
-	// Execute follow-up messaging
-	err := messaging.SendFollowUps(
-		context.Background(),
-		page,
-		profiles,
-		store,
-		msgCfg,
-		cfg.Timing,
-		log,
-	)
-
-	if err != nil {
-		log.Errorf("❌ Follow-up messaging failed: %v", err)
-		return
-	}
-
-	log.Info("✅ Follow-up messaging completed")
-}
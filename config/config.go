@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/viper"
@@ -13,27 +14,234 @@ type BrowserConfig struct {
 	MinViewport int      `mapstructure:"min_viewport"`
 	MaxViewport int      `mapstructure:"max_viewport"`
 	Bin         string   `mapstructure:"bin"`
+
+	// Mode selects how main() obtains a browser: "launch" (default) starts
+	// Bin itself, "connect" attaches to an already-running Chrome over
+	// WSEndpoint instead, and "persistent" launches Bin against UserDataDir
+	// so cookies and "remember me" state survive process restarts.
+	Mode string `mapstructure:"mode"`
+	// WSEndpoint is the CDP WebSocket URL to attach to in "connect" mode,
+	// e.g. "ws://localhost:9222/devtools/browser/<id>". Also settable via
+	// LINKEDIN_BROWSER_WS_URL; a non-empty env value implies "connect" mode
+	// even if Mode is left at its default.
+	WSEndpoint string `mapstructure:"ws_endpoint"`
+	// UserDataDir is the Chrome profile directory "persistent" mode launches
+	// against. Required when Mode is "persistent".
+	UserDataDir string `mapstructure:"user_data_dir"`
 }
 
 type TimingConfig struct {
 	MinDelayMs int `mapstructure:"min_delay_ms"`
 	// MaxDelayMs controls the upper bound for human-like pacing between actions.
 	MaxDelayMs int `mapstructure:"max_delay_ms"`
+
+	// KeyboardLayout selects the physical key adjacency stealth.TypeHuman
+	// draws typos from: "qwerty" (default), "azerty", or "dvorak". Matching
+	// it to the configured user-agent's locale keeps typos looking native
+	// instead of a US layout leaking through on a non-US profile.
+	KeyboardLayout string `mapstructure:"keyboard_layout"`
+
+	// TypoSubstitutionProb, TypoTranspositionProb, TypoInsertionProb, and
+	// TypoOmissionProb are the per-character odds (0-1) stealth.TypeHuman
+	// introduces each class of typo: a neighboring key typed instead of the
+	// right one, two adjacent characters swapped, an extra neighboring key
+	// inserted, or a character skipped and fixed a few keystrokes later.
+	// Zero for all four falls back to stealth's built-in defaults
+	// (~2% / 1% / 0.5% / 0.5%).
+	TypoSubstitutionProb  float64 `mapstructure:"typo_substitution_prob"`
+	TypoTranspositionProb float64 `mapstructure:"typo_transposition_prob"`
+	TypoInsertionProb     float64 `mapstructure:"typo_insertion_prob"`
+	TypoOmissionProb      float64 `mapstructure:"typo_omission_prob"`
 }
 
 type LimitsConfig struct {
 	DailyConnections int `mapstructure:"daily_connections"`
+	// DailyMessages and DailyViews are loaded but not read outside this
+	// package: no scheduler.Pacer Budgets are built from them, and they do
+	// not affect messaging.FollowUpConfig.MaxPerDay (that one's a
+	// sliding-window cap on the old single-shot loop). Zero means
+	// unlimited, matching the rest of this package's "0 disables" convention.
+	DailyMessages int `mapstructure:"daily_messages"`
+	DailyViews    int `mapstructure:"daily_views"`
+}
+
+// PacingConfig holds scheduler.Pacer settings: how often it fires an action
+// on average, how that's jittered, and how activity clusters by hour of day.
+// No code builds a Pacer from it.
+// This is deliberately separate from Schedules (the agent package's
+// cron-driven task dispatch) - Pacing describes a continuous human-behavior
+// rhythm, not fixed time slots.
+type PacingConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// IntervalMs is the average time between actions.
+	IntervalMs int `mapstructure:"interval_ms"`
+	// JitterMs randomizes IntervalMs by +/- up to this much; must be less
+	// than IntervalMs.
+	JitterMs int `mapstructure:"jitter_ms"`
+	// HourlyWeights, if non-empty, must have exactly 24 entries (index =
+	// hour of day) summing to more than zero. Hours above the daily average
+	// get a shorter effective interval.
+	HourlyWeights []float64 `mapstructure:"hourly_weights"`
+	// RandSeed makes the pacer's weighted choice and jitter reproducible
+	// (e.g. via a --rand-seed flag); zero seeds from the current time.
+	RandSeed int64 `mapstructure:"rand_seed"`
 }
 
 type LoggingConfig struct {
 	Level string `mapstructure:"level"`
 }
 
+// MetricsConfig controls the Prometheus /metrics exporter.
+type MetricsConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Listen is the address the HTTP server binds, e.g. ":9090".
+	Listen string `mapstructure:"listen"`
+}
+
+// ControlConfig controls the localhost-bound HTTP control-plane API used to
+// inspect and drive the agent interactively (status, pause/resume, ad-hoc
+// campaigns). The bearer token is read from CONTROL_API_TOKEN, not config,
+// to keep secrets out of config.yaml.
+type ControlConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Listen is the address the HTTP server binds, e.g. "127.0.0.1:8765".
+	Listen string `mapstructure:"listen"`
+}
+
+// AdminConfig controls the app package's admin HTTP server, which exposes
+// /-/ready, /-/healthy, and /-/metrics for process supervisors (k8s
+// liveness/readiness probes, systemd, etc.) - distinct from MetricsConfig's
+// Prometheus exporter and ControlConfig's interactive API. Its /-/metrics
+// serves the same collector as MetricsConfig's exporter; the control API is
+// not served on this port.
+type AdminConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Listen is the address the HTTP server binds, e.g. "127.0.0.1:8766".
+	Listen string `mapstructure:"listen"`
+}
+
+// SecurityConfig controls at-rest protection of persisted session state.
+type SecurityConfig struct {
+	// FernetKeys are base64-encoded 32-byte keys, newest-first. The first
+	// key encrypts new sessions; any key may decrypt an existing one, which
+	// is what makes rotation (prepending a new key) safe.
+	FernetKeys []string `mapstructure:"fernet_keys"`
+	// SessionTTLMinutes bounds how long an encrypted session blob is
+	// considered valid, independent of the cookies' own Expires fields.
+	SessionTTLMinutes int `mapstructure:"session_ttl_minutes"`
+}
+
+// SessionStoreConfig selects and configures the backend used for
+// ticket-based, multi-account session storage (see auth.SessionTicket).
+// This is also the backend main() uses in place of storage.FileStore.
+type SessionStoreConfig struct {
+	// Backend is "file" (default), "redis", or "s3".
+	Backend string `mapstructure:"backend"`
+	// RedisURL is a direct "host:port" address. Ignored if RedisSentinelAddrs is set.
+	RedisURL string `mapstructure:"redis_url"`
+	// RedisSentinelAddrs, when non-empty, switches to Sentinel-based discovery.
+	RedisSentinelAddrs []string `mapstructure:"redis_sentinel_addrs"`
+	// RedisMasterName is required when RedisSentinelAddrs is set.
+	RedisMasterName string `mapstructure:"redis_master_name"`
+	// RedisTTLMinutes expires keys after the given number of minutes. Zero
+	// means no expiry.
+	RedisTTLMinutes int `mapstructure:"redis_ttl_minutes"`
+
+	// S3Bucket is required for backend=s3.
+	S3Bucket string `mapstructure:"s3_bucket"`
+	// S3Region overrides the region resolved from the default AWS config chain.
+	S3Region string `mapstructure:"s3_region"`
+	// S3SSEKMSKeyID, when set, requests SSE-KMS with this key instead of the
+	// bucket's default SSE-S3 encryption.
+	S3SSEKMSKeyID string `mapstructure:"s3_sse_kms_key_id"`
+
+	// Encrypt, when true, wraps the chosen backend in a storage.EncryptedStore
+	// that AES-GCM-encrypts blobs using the key from STORAGE_ENCRYPTION_KEY.
+	Encrypt bool `mapstructure:"encrypt"`
+}
+
+// CSEConfig configures the search package's CSESource, which finds LinkedIn
+// profiles through a general web search engine's "site:linkedin.com/in/"
+// results instead of scraping LinkedIn directly.
+type CSEConfig struct {
+	// Provider is "google" (Custom Search JSON API) or "bing" (Bing Web
+	// Search API).
+	Provider string `mapstructure:"provider"`
+	APIKey   string `mapstructure:"api_key"`
+	// CX is the Google Programmable Search Engine ID; required for
+	// provider=google, ignored for provider=bing.
+	CX string `mapstructure:"cx"`
+}
+
+// SearchAggregatorConfig configures search.Aggregator, which fans a people
+// search out to LinkedIn, Sales Navigator, a web search engine's cached
+// index, and a local cache, then merges and ranks the results.
+type SearchAggregatorConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// SourceTimeoutMs bounds how long any single source gets before the
+	// aggregator moves on without it.
+	SourceTimeoutMs int       `mapstructure:"source_timeout_ms"`
+	CSE             CSEConfig `mapstructure:"cse"`
+}
+
+// CheckpointConfig selects and configures the ChallengeSolver used to
+// resolve LinkedIn checkpoint/challenge pages automatically.
+type CheckpointConfig struct {
+	// Solver is "" (disabled, fail fast with ErrCheckpoint), "totp", or "email_otp".
+	Solver     string `mapstructure:"solver"`
+	TOTPSecret string `mapstructure:"totp_secret"`
+
+	IMAPHost     string `mapstructure:"imap_host"`
+	IMAPUsername string `mapstructure:"imap_username"`
+	IMAPPassword string `mapstructure:"imap_password"`
+	IMAPMailbox  string `mapstructure:"imap_mailbox"`
+}
+
+// OAuthConfig enables the OAuth2 "Sign in with LinkedIn" login path as an
+// alternative to scraping cookies through the browser UI.
+type OAuthConfig struct {
+	// Enabled, when true, makes LoginOrRestoreSession prefer a stored OAuth
+	// token over launching the browser login flow.
+	Enabled      bool     `mapstructure:"enabled"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	RedirectURL  string   `mapstructure:"redirect_url"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+// ScheduleConfig binds a registered agent.Task name to a cron expression and
+// the parameters passed to that task's Run.
+type ScheduleConfig struct {
+	// Task is the name a Task was registered under, e.g. "search" or "connect".
+	Task string `mapstructure:"task"`
+	// Cron is a 5-field "minute hour dom month dow" expression; each field is
+	// "*" or a comma-separated list of integers (no step/range syntax).
+	Cron string `mapstructure:"cron"`
+	// Params is passed to the task's Run, e.g. {"keywords": "software engineer"}.
+	Params map[string]interface{} `mapstructure:"params"`
+}
+
 type Config struct {
-	Browser BrowserConfig `mapstructure:"browser"`
-	Timing  TimingConfig  `mapstructure:"timing"`
-	Limits  LimitsConfig  `mapstructure:"limits"`
-	Logging LoggingConfig `mapstructure:"logging"`
+	Browser          BrowserConfig          `mapstructure:"browser"`
+	Timing           TimingConfig           `mapstructure:"timing"`
+	Limits           LimitsConfig           `mapstructure:"limits"`
+	Logging          LoggingConfig          `mapstructure:"logging"`
+	Metrics          MetricsConfig          `mapstructure:"metrics"`
+	Control          ControlConfig          `mapstructure:"control"`
+	Admin            AdminConfig            `mapstructure:"admin"`
+	SearchAggregator SearchAggregatorConfig `mapstructure:"search_aggregator"`
+	Security         SecurityConfig         `mapstructure:"security"`
+	SessionStore     SessionStoreConfig     `mapstructure:"session_store"`
+	Checkpoint       CheckpointConfig       `mapstructure:"checkpoint"`
+	OAuth            OAuthConfig            `mapstructure:"oauth"`
+	// Schedules drives the agent package's scheduler. Empty means no
+	// scheduled tasks run; the agent then only runs tasks dispatched ad hoc.
+	Schedules []ScheduleConfig `mapstructure:"schedules"`
+	// Pacing holds scheduler.Pacer settings for continuous, weighted-random
+	// activity instead of fixed cron slots. It is validated when Enabled is
+	// set, but no code builds a Pacer from it, so it has no effect on a run.
+	// Disabled by default.
+	Pacing PacingConfig `mapstructure:"pacing"`
 }
 
 func Load(path string) (*Config, error) {
@@ -53,6 +261,14 @@ func Load(path string) (*Config, error) {
 		return nil, fmt.Errorf("unmarshal config: %w", err)
 	}
 
+	// LINKEDIN_BROWSER_WS_URL, like LINKEDIN_EMAIL/LINKEDIN_PASSWORD, is read
+	// directly rather than through the generic mapstructure-to-ENV replacer,
+	// since its name doesn't follow the browser.ws_endpoint -> BROWSER_WS_ENDPOINT
+	// convention the rest of this config uses.
+	if ws, ok := os.LookupEnv("LINKEDIN_BROWSER_WS_URL"); ok && ws != "" {
+		cfg.Browser.WSEndpoint = ws
+	}
+
 	if err := cfg.validate(); err != nil {
 		return nil, fmt.Errorf("config validation: %w", err)
 	}
@@ -69,13 +285,60 @@ func setDefaults() {
 	viper.SetDefault("browser.min_viewport", 1280)
 	viper.SetDefault("browser.max_viewport", 1600)
 	viper.SetDefault("browser.bin", "")
+	viper.SetDefault("browser.mode", "launch")
+	viper.SetDefault("browser.ws_endpoint", "")
+	viper.SetDefault("browser.user_data_dir", "")
 
 	viper.SetDefault("timing.min_delay_ms", 750)
 	viper.SetDefault("timing.max_delay_ms", 2250)
+	viper.SetDefault("timing.keyboard_layout", "qwerty")
+	viper.SetDefault("timing.typo_substitution_prob", 0.0)
+	viper.SetDefault("timing.typo_transposition_prob", 0.0)
+	viper.SetDefault("timing.typo_insertion_prob", 0.0)
+	viper.SetDefault("timing.typo_omission_prob", 0.0)
 
 	viper.SetDefault("limits.daily_connections", 50)
+	viper.SetDefault("limits.daily_messages", 20)
+	viper.SetDefault("limits.daily_views", 100)
+
+	viper.SetDefault("pacing.enabled", false)
+	viper.SetDefault("pacing.interval_ms", 600000) // 10 minutes
+	viper.SetDefault("pacing.jitter_ms", 120000)    // +/- 2 minutes
+	viper.SetDefault("pacing.rand_seed", 0)
 
 	viper.SetDefault("logging.level", "info")
+
+	viper.SetDefault("metrics.enabled", false)
+	viper.SetDefault("metrics.listen", ":9090")
+
+	viper.SetDefault("control.enabled", false)
+	viper.SetDefault("control.listen", "127.0.0.1:8765")
+
+	viper.SetDefault("admin.enabled", false)
+	viper.SetDefault("admin.listen", "127.0.0.1:8766")
+
+	viper.SetDefault("search_aggregator.enabled", false)
+	viper.SetDefault("search_aggregator.source_timeout_ms", 20000)
+	viper.SetDefault("search_aggregator.cse.provider", "google")
+
+	viper.SetDefault("security.fernet_keys", []string{})
+	viper.SetDefault("security.session_ttl_minutes", 43200) // 30 days
+
+	viper.SetDefault("session_store.backend", "file")
+	viper.SetDefault("session_store.redis_url", "")
+	viper.SetDefault("session_store.redis_sentinel_addrs", []string{})
+	viper.SetDefault("session_store.redis_master_name", "")
+	viper.SetDefault("session_store.redis_ttl_minutes", 0)
+	viper.SetDefault("session_store.s3_bucket", "")
+	viper.SetDefault("session_store.s3_region", "")
+	viper.SetDefault("session_store.s3_sse_kms_key_id", "")
+	viper.SetDefault("session_store.encrypt", false)
+
+	viper.SetDefault("checkpoint.solver", "")
+	viper.SetDefault("checkpoint.imap_mailbox", "INBOX")
+
+	viper.SetDefault("oauth.enabled", false)
+	viper.SetDefault("oauth.scopes", []string{"r_liteprofile", "w_member_social"})
 }
 
 func (c *Config) validate() error {
@@ -88,6 +351,27 @@ func (c *Config) validate() error {
 	if c.Browser.MaxViewport <= c.Browser.MinViewport {
 		return fmt.Errorf("browser.max_viewport must be greater than min_viewport")
 	}
+	c.Browser.Mode = strings.ToLower(strings.TrimSpace(c.Browser.Mode))
+	if c.Browser.Mode == "" {
+		c.Browser.Mode = "launch"
+	}
+	if c.Browser.WSEndpoint != "" && c.Browser.Mode == "launch" {
+		c.Browser.Mode = "connect"
+	}
+	switch c.Browser.Mode {
+	case "launch":
+	case "connect":
+		if c.Browser.WSEndpoint == "" {
+			return fmt.Errorf("browser.ws_endpoint is required for browser.mode=connect")
+		}
+	case "persistent":
+		if c.Browser.UserDataDir == "" {
+			return fmt.Errorf("browser.user_data_dir is required for browser.mode=persistent")
+		}
+	default:
+		return fmt.Errorf("browser.mode must be \"launch\", \"connect\", or \"persistent\", got %q", c.Browser.Mode)
+	}
+
 	if c.Timing.MinDelayMs <= 0 || c.Timing.MaxDelayMs <= 0 {
 		return fmt.Errorf("timing delays must be positive")
 	}
@@ -98,8 +382,81 @@ func (c *Config) validate() error {
 		return fmt.Errorf("limits.daily_connections must be positive")
 	}
 
+	if c.Pacing.Enabled {
+		if c.Pacing.IntervalMs <= 0 {
+			return fmt.Errorf("pacing.interval_ms must be positive")
+		}
+		if c.Pacing.JitterMs < 0 || c.Pacing.JitterMs >= c.Pacing.IntervalMs {
+			return fmt.Errorf("pacing.jitter_ms must be non-negative and less than pacing.interval_ms")
+		}
+		if len(c.Pacing.HourlyWeights) > 0 {
+			if len(c.Pacing.HourlyWeights) != 24 {
+				return fmt.Errorf("pacing.hourly_weights must have exactly 24 entries, got %d", len(c.Pacing.HourlyWeights))
+			}
+			var sum float64
+			for _, w := range c.Pacing.HourlyWeights {
+				sum += w
+			}
+			if sum <= 0 {
+				return fmt.Errorf("pacing.hourly_weights must sum to more than zero")
+			}
+		}
+	}
+
 	c.Logging.Level = strings.ToLower(c.Logging.Level)
 
+	c.SessionStore.Backend = strings.ToLower(c.SessionStore.Backend)
+	switch c.SessionStore.Backend {
+	case "file":
+		// no extra requirements
+	case "redis":
+		if c.SessionStore.RedisURL == "" && len(c.SessionStore.RedisSentinelAddrs) == 0 {
+			return fmt.Errorf("session_store.redis_url or session_store.redis_sentinel_addrs is required for backend=redis")
+		}
+		if len(c.SessionStore.RedisSentinelAddrs) > 0 && c.SessionStore.RedisMasterName == "" {
+			return fmt.Errorf("session_store.redis_master_name is required when using redis_sentinel_addrs")
+		}
+	case "s3":
+		if c.SessionStore.S3Bucket == "" {
+			return fmt.Errorf("session_store.s3_bucket is required for backend=s3")
+		}
+	default:
+		return fmt.Errorf("session_store.backend must be \"file\", \"redis\", or \"s3\", got %q", c.SessionStore.Backend)
+	}
+
+	c.Checkpoint.Solver = strings.ToLower(c.Checkpoint.Solver)
+	switch c.Checkpoint.Solver {
+	case "", "totp", "email_otp":
+	default:
+		return fmt.Errorf("checkpoint.solver must be \"\", \"totp\", or \"email_otp\", got %q", c.Checkpoint.Solver)
+	}
+
+	if c.Metrics.Enabled && c.Metrics.Listen == "" {
+		return fmt.Errorf("metrics.listen is required when metrics.enabled is true")
+	}
+
+	if c.Control.Enabled && c.Control.Listen == "" {
+		return fmt.Errorf("control.listen is required when control.enabled is true")
+	}
+
+	if c.OAuth.Enabled {
+		if c.OAuth.ClientID == "" || c.OAuth.ClientSecret == "" {
+			return fmt.Errorf("oauth.client_id and oauth.client_secret are required when oauth.enabled is true")
+		}
+		if c.OAuth.RedirectURL == "" {
+			return fmt.Errorf("oauth.redirect_url is required when oauth.enabled is true")
+		}
+	}
+
+	for i, sched := range c.Schedules {
+		if sched.Task == "" {
+			return fmt.Errorf("schedules[%d].task must not be empty", i)
+		}
+		if sched.Cron == "" {
+			return fmt.Errorf("schedules[%d].cron must not be empty", i)
+		}
+	}
+
 	return nil
 }
 
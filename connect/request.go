@@ -33,11 +33,17 @@ type RequestConfig struct {
 }
 
 const (
-	stateKeyConnectionState = "connection_state"
+	// StateKeyConnectionState is the StateStore key ConnectionState is
+	// persisted under. Exported so other packages (e.g. metrics) can read
+	// the same state without duplicating the key.
+	StateKeyConnectionState = "connection_state"
 	maxNoteLength           = 300 // LinkedIn's character limit
 )
 
-// SendRequests sends connection requests to a list of profile URLs with rate limiting
+// SendRequests sends connection requests to a list of profile URLs with rate
+// limiting. It returns how many requests were sent, skipped (already
+// attempted), and failed, so callers can report per-result metrics without
+// re-deriving them from logs.
 func SendRequests(
 	ctx context.Context,
 	page *rod.Page,
@@ -46,11 +52,11 @@ func SendRequests(
 	reqCfg RequestConfig,
 	timingCfg config.TimingConfig,
 	log *zap.SugaredLogger,
-) error {
+) (int, int, int, error) {
 
 	if len(profiles) == 0 {
 		log.Info("no profiles provided for connection requests")
-		return nil
+		return 0, 0, 0, nil
 	}
 
 	log.Infow("starting connection request campaign",
@@ -80,7 +86,7 @@ func SendRequests(
 			"sent", state.RequestsSentToday,
 			"limit", reqCfg.MaxPerDay,
 		)
-		return fmt.Errorf("daily connection limit reached: %d/%d", state.RequestsSentToday, reqCfg.MaxPerDay)
+		return 0, 0, 0, fmt.Errorf("daily connection limit reached: %d/%d", state.RequestsSentToday, reqCfg.MaxPerDay)
 	}
 
 	successCount := 0
@@ -88,6 +94,10 @@ func SendRequests(
 	errorCount := 0
 
 	for i, profileURL := range profiles {
+		if ctx.Err() != nil {
+			return successCount, skipCount, errorCount, ctx.Err()
+		}
+
 		// Check daily limit before each attempt
 		if state.RequestsSentToday >= reqCfg.MaxPerDay {
 			log.Warnw("daily connection limit reached during campaign",
@@ -154,7 +164,9 @@ func SendRequests(
 			waitTime += stealth.RandomDelay(2000, 5000)
 
 			log.Infow("waiting before next request", "duration", waitTime)
-			time.Sleep(waitTime)
+			if err := stealth.SleepCtx(ctx, waitTime); err != nil {
+				return successCount, skipCount, errorCount, err
+			}
 		}
 	}
 
@@ -166,7 +178,7 @@ func SendRequests(
 		"limit", reqCfg.MaxPerDay,
 	)
 
-	return nil
+	return successCount, skipCount, errorCount, nil
 }
 
 // sendConnectionRequest sends a single connection request to a profile
@@ -181,16 +193,19 @@ func sendConnectionRequest(
 
 	// Navigate to profile
 	log.Infow("navigating to profile", "url", profileURL)
-	if err := page.Timeout(30 * time.Second).Navigate(profileURL); err != nil {
+	timedPage := page.Context(ctx).Timeout(30 * time.Second)
+	if err := timedPage.Navigate(profileURL); err != nil {
 		return fmt.Errorf("navigate to profile: %w", err)
 	}
 
-	if err := page.Timeout(30 * time.Second).WaitLoad(); err != nil {
+	if err := timedPage.WaitLoad(); err != nil {
 		return fmt.Errorf("wait for profile load: %w", err)
 	}
 
 	// Wait for profile to render
-	time.Sleep(3 * time.Second)
+	if err := stealth.SleepCtx(ctx, 3*time.Second); err != nil {
+		return err
+	}
 
 	// Check if profile is available
 	if !isProfileAvailable(page, log) {
@@ -199,7 +214,10 @@ func sendConnectionRequest(
 
 	// Scroll naturally to see profile content
 	log.Debug("scrolling profile page naturally")
-	if err := humanScrollProfile(page, timingCfg); err != nil {
+	if err := humanScrollProfile(ctx, page, timingCfg); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 		log.Warnw("profile scroll failed", "error", err)
 	}
 
@@ -211,12 +229,14 @@ func sendConnectionRequest(
 
 	// Move mouse to button with human-like motion
 	log.Debug("moving mouse to Connect button")
-	if err := stealth.MoveToElementHuman(page, connectBtn, timingCfg); err != nil {
+	if err := stealth.MoveToElementHuman(ctx, page, connectBtn, timingCfg); err != nil {
 		log.Warnw("mouse movement to button failed, clicking directly", "error", err)
 	}
 
 	// Small hover pause
-	time.Sleep(stealth.RandomDelay(500, 1200))
+	if err := stealth.SleepCtx(ctx, stealth.RandomDelay(500, 1200)); err != nil {
+		return err
+	}
 
 	// Click Connect button
 	log.Info("clicking Connect button")
@@ -225,13 +245,15 @@ func sendConnectionRequest(
 	}
 
 	// Wait for modal or confirmation
-	time.Sleep(2 * time.Second)
+	if err := stealth.SleepCtx(ctx, 2*time.Second); err != nil {
+		return err
+	}
 
 	// Check if "Add a note" modal appeared
 	if hasNoteModal(page, log) {
 		if reqCfg.UsePersonalizedNotes && reqCfg.NoteTemplate != "" {
 			log.Info("note modal detected, adding personalized note")
-			if err := addPersonalizedNote(page, reqCfg.NoteTemplate, timingCfg, log); err != nil {
+			if err := addPersonalizedNote(ctx, page, reqCfg.NoteTemplate, timingCfg, log); err != nil {
 				log.Warnw("failed to add note, sending without note", "error", err)
 				// Try to send without note
 				if err := clickSendWithoutNote(page, log); err != nil {
@@ -251,9 +273,7 @@ func sendConnectionRequest(
 	}
 
 	// Final wait to let request process
-	time.Sleep(2 * time.Second)
-
-	return nil
+	return stealth.SleepCtx(ctx, 2*time.Second)
 }
 
 // isProfileAvailable checks if the profile page loaded successfully
@@ -365,6 +385,7 @@ func hasNoteModal(page *rod.Page, log *zap.SugaredLogger) bool {
 
 // addPersonalizedNote adds a personalized note to the connection request
 func addPersonalizedNote(
+	ctx context.Context,
 	page *rod.Page,
 	noteTemplate string,
 	timingCfg config.TimingConfig,
@@ -390,7 +411,9 @@ func addPersonalizedNote(
 		}
 
 		clickedAddNote = true
-		time.Sleep(time.Second)
+		if err := stealth.SleepCtx(ctx, time.Second); err != nil {
+			return err
+		}
 		break
 	}
 
@@ -437,16 +460,20 @@ func addPersonalizedNote(
 		return fmt.Errorf("click textarea: %w", err)
 	}
 
-	time.Sleep(stealth.RandomDelay(300, 700))
+	if err := stealth.SleepCtx(ctx, stealth.RandomDelay(300, 700)); err != nil {
+		return err
+	}
 
 	// Type note with human-like behavior
 	log.Infow("typing personalized note", "length", len(note))
-	if err := stealth.TypeHuman(textarea, note, timingCfg); err != nil {
+	if err := stealth.TypeHuman(ctx, textarea, note, timingCfg); err != nil {
 		return fmt.Errorf("type note: %w", err)
 	}
 
 	// Wait after typing (human reads what they typed)
-	time.Sleep(stealth.RandomDelay(1000, 2000))
+	if err := stealth.SleepCtx(ctx, stealth.RandomDelay(1000, 2000)); err != nil {
+		return err
+	}
 
 	// Click Send button
 	sendSelectors := []string{
@@ -560,11 +587,15 @@ func personalizeNote(template string, firstName string) string {
 }
 
 // humanScrollProfile scrolls the profile page naturally
-func humanScrollProfile(page *rod.Page, timingCfg config.TimingConfig) error {
+func humanScrollProfile(ctx context.Context, page *rod.Page, timingCfg config.TimingConfig) error {
 	// Scroll down 2-3 times to view profile sections
 	scrolls := 2 + (int(time.Now().UnixNano()) % 2) // 2-3 scrolls
 
 	for i := 0; i < scrolls; i++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		scrollDistance := 300 + (time.Now().UnixNano() % 300) // 300-600px
 
 		if err := page.Mouse.Scroll(0, float64(scrollDistance), 1); err != nil {
@@ -572,18 +603,18 @@ func humanScrollProfile(page *rod.Page, timingCfg config.TimingConfig) error {
 		}
 
 		// Pause between scrolls
-		time.Sleep(stealth.RandomDelay(
+		if err := stealth.SleepCtx(ctx, stealth.RandomDelay(
 			timingCfg.MinDelayMs,
 			timingCfg.MaxDelayMs,
-		))
+		)); err != nil {
+			return err
+		}
 	}
 
 	// Scroll back up slightly (human behavior)
 	backScroll := 100 + (time.Now().UnixNano() % 100)
 	_ = page.Mouse.Scroll(0, -float64(backScroll), 1)
-	time.Sleep(stealth.RandomDelay(500, 1000))
-
-	return nil
+	return stealth.SleepCtx(ctx, stealth.RandomDelay(500, 1000))
 }
 
 // newConnectionState creates a new connection state
@@ -599,7 +630,7 @@ func newConnectionState() *ConnectionState {
 
 // loadConnectionState loads connection state from storage
 func loadConnectionState(ctx context.Context, store storage.StateStore, log *zap.SugaredLogger) (*ConnectionState, error) {
-	data, err := store.Load(ctx, stateKeyConnectionState)
+	data, err := store.Load(ctx, StateKeyConnectionState)
 	if err != nil {
 		if err == storage.ErrNotFound {
 			return newConnectionState(), nil
@@ -640,7 +671,7 @@ func saveConnectionState(ctx context.Context, store storage.StateStore, state *C
 		return fmt.Errorf("marshal connection state: %w", err)
 	}
 
-	if err := store.Save(ctx, stateKeyConnectionState, data); err != nil {
+	if err := store.Save(ctx, StateKeyConnectionState, data); err != nil {
 		return fmt.Errorf("save connection state: %w", err)
 	}
 
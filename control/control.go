@@ -0,0 +1,230 @@
+// Package control exposes a localhost-bound HTTP API for inspecting and
+// driving a running agent.Agent interactively, so users don't have to edit
+// main.go and its hardcoded campaign params to try something ad hoc.
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-rod/rod"
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/agent"
+	"linkedin-automation-tool/metrics"
+	"linkedin-automation-tool/storage"
+)
+
+// APITokenEnv is the environment variable the bearer token is read from.
+// Kept out of config.yaml, matching how storage.EncryptedStoreKeyEnv keeps
+// the encryption key out of config.yaml.
+const APITokenEnv = "CONTROL_API_TOKEN"
+
+// searchCampaignRequest is the POST /campaigns/search body.
+type searchCampaignRequest struct {
+	Keywords string `json:"keywords"`
+	JobTitle string `json:"job_title"`
+	Company  string `json:"company"`
+	Location string `json:"location"`
+	MaxPages int    `json:"max_pages"`
+}
+
+// connectCampaignRequest is the POST /campaigns/connect body.
+type connectCampaignRequest struct {
+	Profiles             []string `json:"profiles"`
+	MaxPerDay            int      `json:"max_per_day"`
+	UsePersonalizedNotes bool     `json:"use_personalized_notes"`
+	NoteTemplate         string   `json:"note_template"`
+	WaitBetweenRequests  int      `json:"wait_between_requests_ms"`
+}
+
+type statusResponse struct {
+	Paused         bool           `json:"paused"`
+	CachedProfiles int            `json:"cached_profiles"`
+	Schedules      int            `json:"schedules"`
+	QuotaRemaining map[string]int `json:"quota_remaining"`
+}
+
+// Serve starts the control-plane HTTP server on listen, authenticating every
+// request against the token in APITokenEnv, and shuts it down when ctx is
+// done. It runs in the background; call errors are logged, not returned,
+// matching how the rest of main() treats best-effort background work.
+func Serve(ctx context.Context, listen string, ag *agent.Agent, store storage.StateStore, limits metrics.QuotaLimits, log *zap.SugaredLogger) {
+	token := os.Getenv(APITokenEnv)
+	if token == "" {
+		log.Warnw("control: " + APITokenEnv + " is not set, refusing to start control-plane server")
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", handleStatus(ag, store, limits, log))
+	mux.HandleFunc("/pause", handlePause(ag))
+	mux.HandleFunc("/resume", handleResume(ag))
+	mux.HandleFunc("/campaigns/search", handleSearchCampaign(ag, log))
+	mux.HandleFunc("/campaigns/connect", handleConnectCampaign(ag, log))
+	mux.HandleFunc("/screenshot", handleScreenshot(ag, log))
+
+	srv := &http.Server{Addr: listen, Handler: authMiddleware(token, mux)}
+
+	go func() {
+		log.Infow("control-plane server listening", "addr", listen)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Errorw("control-plane server failed", "error", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Warnw("control-plane server shutdown error", "error", err)
+		}
+	}()
+}
+
+func authMiddleware(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func handleStatus(ag *agent.Agent, store storage.StateStore, limits metrics.QuotaLimits, log *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp := statusResponse{
+			Paused:         ag.Paused(),
+			CachedProfiles: len(ag.Profiles()),
+			Schedules:      len(ag.Schedules()),
+			QuotaRemaining: metrics.RemainingToday(r.Context(), store, limits, log),
+		}
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+func handlePause(ag *agent.Agent) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		ag.Pause()
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func handleResume(ag *agent.Agent) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		ag.Resume()
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func handleSearchCampaign(ag *agent.Agent, log *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var req searchCampaignRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		params := map[string]interface{}{
+			"keywords":  req.Keywords,
+			"job_title": req.JobTitle,
+			"company":   req.Company,
+			"location":  req.Location,
+			"max_pages": req.MaxPages,
+		}
+		if err := ag.Dispatch("search", params); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		log.Infow("control: search campaign dispatched", "keywords", req.Keywords, "location", req.Location)
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func handleConnectCampaign(ag *agent.Agent, log *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var req connectCampaignRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(req.Profiles) == 0 {
+			http.Error(w, "profiles must not be empty", http.StatusBadRequest)
+			return
+		}
+
+		ag.SetProfiles(req.Profiles)
+		params := map[string]interface{}{
+			"max_per_day":              req.MaxPerDay,
+			"use_personalized_notes":   req.UsePersonalizedNotes,
+			"note_template":            req.NoteTemplate,
+			"wait_between_requests_ms": req.WaitBetweenRequests,
+		}
+		if err := ag.Dispatch("connect", params); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		log.Infow("control: connect campaign dispatched", "profiles", len(req.Profiles))
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func handleScreenshot(ag *agent.Agent, log *zap.SugaredLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var png []byte
+		err := ag.Submit(r.Context(), func(ctx context.Context, page *rod.Page) error {
+			var err error
+			png, err = page.Screenshot(true, nil)
+			return err
+		})
+		if err != nil {
+			log.Errorw("control: screenshot failed", "error", err)
+			http.Error(w, "screenshot failed: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(png)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -2,6 +2,7 @@ package logger
 
 import (
 	"fmt"
+	"os"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -33,5 +34,34 @@ func New(level string) (*zap.Logger, error) {
 	return cfg.Build()
 }
 
+// NewTee builds a JSON logger with the same encoder and level as New that
+// writes every log entry to stdout and to sink, e.g. so the tui package's log
+// panel can tail output without scraping stdout. Unlike New, it does not
+// sample entries or record the caller, and it writes to stdout, not stderr.
+func NewTee(level string, sink zapcore.WriteSyncer) (*zap.Logger, error) {
+	var lvl zapcore.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q: %w", level, err)
+	}
 
+	encoderCfg := zapcore.EncoderConfig{
+		TimeKey:        "ts",
+		LevelKey:       "level",
+		NameKey:        "logger",
+		CallerKey:      "caller",
+		MessageKey:     "msg",
+		StacktraceKey:  "stacktrace",
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeLevel:    zapcore.LowercaseLevelEncoder,
+		EncodeTime:     zapcore.ISO8601TimeEncoder,
+		EncodeDuration: zapcore.StringDurationEncoder,
+		EncodeCaller:   zapcore.ShortCallerEncoder,
+	}
+	encoder := zapcore.NewJSONEncoder(encoderCfg)
 
+	core := zapcore.NewTee(
+		zapcore.NewCore(encoder, zapcore.AddSync(os.Stdout), lvl),
+		zapcore.NewCore(encoder, sink, lvl),
+	)
+	return zap.New(core), nil
+}
@@ -0,0 +1,66 @@
+package messaging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// AlertSink posts human-readable alerts to a Slack-compatible incoming
+// webhook. Matrix bridges and Slack both accept the same {"text": "..."}
+// payload shape for a plain message, so one sink covers either target.
+type AlertSink struct {
+	WebhookURL string
+	Client     *http.Client
+	Log        *zap.SugaredLogger
+}
+
+// NewAlertSink builds an AlertSink posting to webhookURL.
+func NewAlertSink(webhookURL string, log *zap.SugaredLogger) *AlertSink {
+	return &AlertSink{
+		WebhookURL: webhookURL,
+		Client:     &http.Client{Timeout: 10 * time.Second},
+		Log:        log,
+	}
+}
+
+func (a *AlertSink) post(text string) {
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		a.Log.Warnw("marshal alert payload failed", "error", err)
+		return
+	}
+
+	resp, err := a.Client.Post(a.WebhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		a.Log.Warnw("alert delivery failed", "error", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		a.Log.Warnw("alert endpoint returned non-2xx", "status", resp.StatusCode)
+	}
+}
+
+// OnMessageSent is a no-op: a successful send isn't alert-worthy.
+func (a *AlertSink) OnMessageSent(evt MessageEvent) {}
+
+func (a *AlertSink) OnMessageFailed(evt MessageEvent) {
+	a.post(fmt.Sprintf(":warning: follow-up message failed for %s: %s", evt.ProfileURL, evt.Reason))
+}
+
+func (a *AlertSink) OnDailyLimitHit(evt MessageEvent) {
+	a.post(fmt.Sprintf(":stop_sign: messaging limit reached (campaign %s): %s", evt.CampaignID, evt.Reason))
+}
+
+func (a *AlertSink) OnRateLimitDetected(evt MessageEvent) {
+	a.post(fmt.Sprintf(":rotating_light: LinkedIn rate-limit warning detected for %s, backing off", evt.ProfileURL))
+}
+
+func (a *AlertSink) OnMessagingRestricted(evt MessageEvent) {
+	a.post(fmt.Sprintf(":no_entry: messaging restricted for %s: %s", evt.ProfileURL, evt.Reason))
+}
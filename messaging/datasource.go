@@ -0,0 +1,247 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"linkedin-automation-tool/storage"
+)
+
+const (
+	stateKeyCampaigns          = "campaigns"
+	stateKeyProfileQueuePrefix = "campaign_profiles:"
+	stateKeyLinksPrefix        = "campaign_links:"
+)
+
+// Campaign is a durable, multi-channel follow-up job: each queued profile is
+// tried with Channels[0], and Manager moves on to Channels[1] (and so on) as
+// soon as a channel reports the recipient not connected. FallbackAfterDays
+// is stored with the campaign, but Manager does not read it.
+type Campaign struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	Status            string    `json:"status"` // "active", "paused", "done"
+	Channels          []string  `json:"channels"`
+	MessageTemplate   string    `json:"message_template"`
+	Context           string    `json:"context"`
+	FallbackAfterDays int       `json:"fallback_after_days"`
+	NextRunAt         time.Time `json:"next_run_at"`
+}
+
+// IsDue reports whether the campaign is active and ready to be picked up by
+// the scheduler.
+func (c Campaign) IsDue(now time.Time) bool {
+	return c.Status == "active" && !c.NextRunAt.After(now)
+}
+
+// DataSource is Manager's view of campaign/profile persistence, modeled on
+// listmonk's campaign store: the Manager only ever asks for "what's due" and
+// "what's next", never touches storage directly.
+type DataSource interface {
+	// NextCampaigns returns campaigns currently due to run.
+	NextCampaigns(ctx context.Context) ([]Campaign, error)
+	// NextProfiles returns up to limit profile URLs still queued for campID.
+	NextProfiles(ctx context.Context, campID string, limit int) ([]string, error)
+	// UpdateCampaignStatus persists a new status for campID (e.g. "paused"
+	// after NextProfiles returns empty, "done" once exhausted).
+	UpdateCampaignStatus(ctx context.Context, campID, status string) error
+	// CreateLink mints a link for profileURL within campID that embeds both,
+	// and returns it (the same link on repeat calls). No Messenger in this
+	// package calls it.
+	CreateLink(ctx context.Context, campID, profileURL string) (string, error)
+}
+
+// campaignState is the on-disk shape for StoreDataSource: the campaign
+// definitions plus, per campaign, the queue of profiles still owed a
+// message. Queues are stored separately (see stateKeyProfileQueuePrefix) so
+// a campaign with thousands of profiles doesn't rewrite them all whenever
+// another campaign's metadata changes.
+type campaignState struct {
+	Campaigns map[string]Campaign `json:"campaigns"`
+}
+
+// StoreDataSource implements DataSource on top of storage.StateStore, the
+// same interface every other stateful subsystem in this tool already uses.
+type StoreDataSource struct {
+	store storage.StateStore
+}
+
+// NewStoreDataSource builds a StoreDataSource backed by store.
+func NewStoreDataSource(store storage.StateStore) *StoreDataSource {
+	return &StoreDataSource{store: store}
+}
+
+// EnqueueCampaign registers (or replaces) camp and seeds its profile queue.
+// Callers use this to declare campaigns; Manager itself only reads.
+func (d *StoreDataSource) EnqueueCampaign(ctx context.Context, camp Campaign, profiles []string) error {
+	if _, err := CompileTemplate(camp.MessageTemplate); err != nil {
+		return fmt.Errorf("invalid message template for campaign %q: %w", camp.ID, err)
+	}
+
+	state, err := d.loadState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if camp.Status == "" {
+		camp.Status = "active"
+	}
+	state.Campaigns[camp.ID] = camp
+
+	if err := d.saveState(ctx, state); err != nil {
+		return err
+	}
+	return d.saveProfileQueue(ctx, camp.ID, profiles)
+}
+
+func (d *StoreDataSource) NextCampaigns(ctx context.Context) ([]Campaign, error) {
+	state, err := d.loadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	due := make([]Campaign, 0, len(state.Campaigns))
+	for _, camp := range state.Campaigns {
+		if camp.IsDue(now) {
+			due = append(due, camp)
+		}
+	}
+	return due, nil
+}
+
+func (d *StoreDataSource) NextProfiles(ctx context.Context, campID string, limit int) ([]string, error) {
+	queue, err := d.loadProfileQueue(ctx, campID)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > len(queue) {
+		limit = len(queue)
+	}
+
+	batch := queue[:limit]
+	remaining := queue[limit:]
+	if err := d.saveProfileQueue(ctx, campID, remaining); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+func (d *StoreDataSource) UpdateCampaignStatus(ctx context.Context, campID, status string) error {
+	state, err := d.loadState(ctx)
+	if err != nil {
+		return err
+	}
+	camp, ok := state.Campaigns[campID]
+	if !ok {
+		return fmt.Errorf("unknown campaign %q", campID)
+	}
+	camp.Status = status
+	state.Campaigns[campID] = camp
+	return d.saveState(ctx, state)
+}
+
+func (d *StoreDataSource) CreateLink(ctx context.Context, campID, profileURL string) (string, error) {
+	links, err := d.loadLinks(ctx, campID)
+	if err != nil {
+		return "", err
+	}
+	if existing, ok := links[profileURL]; ok {
+		return existing, nil
+	}
+
+	// This link is not registered with LinkTracker, so the /l/{id} handler
+	// in messaging/tracker does not resolve it; it embeds the campaign and
+	// profile so hits can be attributed after the fact.
+	link := fmt.Sprintf("https://link.example/c/%s?u=%s", campID, profileURL)
+	links[profileURL] = link
+	if err := d.saveLinks(ctx, campID, links); err != nil {
+		return "", err
+	}
+	return link, nil
+}
+
+func (d *StoreDataSource) loadState(ctx context.Context) (*campaignState, error) {
+	raw, err := d.store.Load(ctx, stateKeyCampaigns)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return &campaignState{Campaigns: make(map[string]Campaign)}, nil
+		}
+		return nil, fmt.Errorf("load campaigns: %w", err)
+	}
+
+	var state campaignState
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, fmt.Errorf("unmarshal campaigns: %w", err)
+	}
+	if state.Campaigns == nil {
+		state.Campaigns = make(map[string]Campaign)
+	}
+	return &state, nil
+}
+
+func (d *StoreDataSource) saveState(ctx context.Context, state *campaignState) error {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal campaigns: %w", err)
+	}
+	if err := d.store.Save(ctx, stateKeyCampaigns, raw); err != nil {
+		return fmt.Errorf("save campaigns: %w", err)
+	}
+	return nil
+}
+
+func (d *StoreDataSource) loadProfileQueue(ctx context.Context, campID string) ([]string, error) {
+	raw, err := d.store.Load(ctx, stateKeyProfileQueuePrefix+campID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("load profile queue for %s: %w", campID, err)
+	}
+	var queue []string
+	if err := json.Unmarshal(raw, &queue); err != nil {
+		return nil, fmt.Errorf("unmarshal profile queue for %s: %w", campID, err)
+	}
+	return queue, nil
+}
+
+func (d *StoreDataSource) saveProfileQueue(ctx context.Context, campID string, queue []string) error {
+	raw, err := json.Marshal(queue)
+	if err != nil {
+		return fmt.Errorf("marshal profile queue for %s: %w", campID, err)
+	}
+	if err := d.store.Save(ctx, stateKeyProfileQueuePrefix+campID, raw); err != nil {
+		return fmt.Errorf("save profile queue for %s: %w", campID, err)
+	}
+	return nil
+}
+
+func (d *StoreDataSource) loadLinks(ctx context.Context, campID string) (map[string]string, error) {
+	raw, err := d.store.Load(ctx, stateKeyLinksPrefix+campID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return make(map[string]string), nil
+		}
+		return nil, fmt.Errorf("load links for %s: %w", campID, err)
+	}
+	links := make(map[string]string)
+	if err := json.Unmarshal(raw, &links); err != nil {
+		return nil, fmt.Errorf("unmarshal links for %s: %w", campID, err)
+	}
+	return links, nil
+}
+
+func (d *StoreDataSource) saveLinks(ctx context.Context, campID string, links map[string]string) error {
+	raw, err := json.Marshal(links)
+	if err != nil {
+		return fmt.Errorf("marshal links for %s: %w", campID, err)
+	}
+	if err := d.store.Save(ctx, stateKeyLinksPrefix+campID, raw); err != nil {
+		return fmt.Errorf("save links for %s: %w", campID, err)
+	}
+	return nil
+}
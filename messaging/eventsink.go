@@ -0,0 +1,69 @@
+package messaging
+
+import "time"
+
+// MessageEvent carries enough context for an EventSink to format a useful
+// alert without reaching back into messaging internals.
+type MessageEvent struct {
+	ProfileURL string
+	CampaignID string
+	Reason     string // human-readable detail: error text, restriction message, etc.
+	At         time.Time
+}
+
+// EventSink observes campaign-send lifecycle events. SendFollowUps (and the
+// checkIfConnected/findMessageButton helpers it drives) call these instead
+// of only logging, so operators running headlessly can get paged when
+// LinkedIn starts serving "messaging not available" rather than finding out
+// hours later in zap logs.
+type EventSink interface {
+	OnMessageSent(evt MessageEvent)
+	OnMessageFailed(evt MessageEvent)
+	OnDailyLimitHit(evt MessageEvent)
+	OnRateLimitDetected(evt MessageEvent)
+	OnMessagingRestricted(evt MessageEvent)
+}
+
+// noopEventSink discards everything; the default when callers don't wire a
+// sink.
+type noopEventSink struct{}
+
+func (noopEventSink) OnMessageSent(MessageEvent)         {}
+func (noopEventSink) OnMessageFailed(MessageEvent)       {}
+func (noopEventSink) OnDailyLimitHit(MessageEvent)       {}
+func (noopEventSink) OnRateLimitDetected(MessageEvent)   {}
+func (noopEventSink) OnMessagingRestricted(MessageEvent) {}
+
+// MultiSink fans every event out to each sink in order, e.g. a webhook for
+// machine consumption plus a Slack alert for humans.
+type MultiSink []EventSink
+
+func (m MultiSink) OnMessageSent(evt MessageEvent) {
+	for _, s := range m {
+		s.OnMessageSent(evt)
+	}
+}
+
+func (m MultiSink) OnMessageFailed(evt MessageEvent) {
+	for _, s := range m {
+		s.OnMessageFailed(evt)
+	}
+}
+
+func (m MultiSink) OnDailyLimitHit(evt MessageEvent) {
+	for _, s := range m {
+		s.OnDailyLimitHit(evt)
+	}
+}
+
+func (m MultiSink) OnRateLimitDetected(evt MessageEvent) {
+	for _, s := range m {
+		s.OnRateLimitDetected(evt)
+	}
+}
+
+func (m MultiSink) OnMessagingRestricted(evt MessageEvent) {
+	for _, s := range m {
+		s.OnMessagingRestricted(evt)
+	}
+}
@@ -20,6 +20,13 @@ type MessageState struct {
 	Date            string              `json:"date"`             // YYYY-MM-DD format
 	MessagesSentToday int               `json:"messages_sent_today"` // Count for current day
 	MessagedProfiles  map[string]MessageRecord `json:"messaged_profiles"` // Profile URL -> record
+
+	// SendTimestamps is a ring buffer of RFC3339 send times (newest last),
+	// pruned to the last week, backing RateLimiter's sliding windows.
+	SendTimestamps []string `json:"send_timestamps"`
+	// Backoff is the adaptive cool-down state RateLimiter trips after
+	// repeated failures or LinkedIn rate-limit warnings.
+	Backoff BackoffState `json:"backoff"`
 }
 
 // MessageRecord tracks individual message details
@@ -28,18 +35,33 @@ type MessageRecord struct {
 	Timestamp   string `json:"timestamp"`    // RFC3339 format
 	MessageSent string `json:"message_sent"` // Optional: actual message content
 	Success     bool   `json:"success"`
+
+	// TrackedLinks are the click-tracked URLs rewritten into the message as
+	// typed (MessageSent keeps the unrendered template), if LinkTracker
+	// found any. Outreach effectiveness is otherwise invisible beyond Success.
+	TrackedLinks []TrackedLink `json:"tracked_links,omitempty"`
+	Clicked      bool          `json:"clicked"` // not set by the tracker HTTP handler, which records a click only on the stored TrackedLink
+	Replied      bool          `json:"replied"` // set externally once reply detection lands
+	Bounced      bool          `json:"bounced"` // not set by SendFollowUps or Manager
 }
 
 // FollowUpConfig holds configuration for follow-up messaging
 type FollowUpConfig struct {
-	MaxPerDay           int    // Maximum messages per day
+	MaxPerDay           int    // Maximum messages per day (sliding 24h window)
+	MaxPerHour          int    // Maximum messages per rolling hour; 0 disables the check
+	MaxPerWeek          int    // Maximum messages per rolling 7 days; 0 disables the check
 	MessageTemplate     string // Template with {{name}} and {{context}} variables
 	WaitBetweenMessages int    // Milliseconds to wait between messages
+	MinGapBetweenSends  time.Duration // Hard floor on spacing between sends, independent of WaitBetweenMessages jitter
 	Context             string // Optional context for {{context}} variable
+	LinkTrackingBaseURL string // Base URL for click-tracked short links; defaults in NewLinkTracker if empty
 }
 
 const (
-	stateKeyMessageState = "message_state"
+	// StateKeyMessageState is the StateStore key MessageState is persisted
+	// under. Exported so other packages (e.g. metrics) can read the same
+	// state without duplicating the key.
+	StateKeyMessageState = "message_state"
 	maxMessageLength     = 2000 // LinkedIn's approximate character limit for messages
 )
 
@@ -60,6 +82,9 @@ const (
 //
 // IMPORTANT: This function expects a list of profile URLs to check.
 // Typically, these would come from your connection request history.
+//
+// It returns how many messages were sent successfully, so callers can
+// report it as a metric without re-deriving it from logs.
 func SendFollowUps(
 	ctx context.Context,
 	page *rod.Page,
@@ -67,12 +92,17 @@ func SendFollowUps(
 	store storage.StateStore,
 	cfg FollowUpConfig,
 	timingCfg config.TimingConfig,
+	sink EventSink,
 	log *zap.SugaredLogger,
-) error {
+) (int, error) {
+
+	if sink == nil {
+		sink = noopEventSink{}
+	}
 
 	if len(profiles) == 0 {
 		log.Info("no profiles provided for follow-up messaging")
-		return nil
+		return 0, nil
 	}
 
 	log.Infow("starting follow-up messaging campaign",
@@ -80,6 +110,13 @@ func SendFollowUps(
 		"maxPerDay", cfg.MaxPerDay,
 	)
 
+	// Validate the template before doing any browser automation: a bad
+	// template should fail fast, not mid-campaign after profiles 1..N
+	// already got visited.
+	if _, err := CompileTemplate(cfg.MessageTemplate); err != nil {
+		return 0, fmt.Errorf("invalid message template: %w", err)
+	}
+
 	// Load message state
 	state, err := loadMessageState(ctx, store, log)
 	if err != nil {
@@ -95,13 +132,14 @@ func SendFollowUps(
 		state.MessagesSentToday = 0
 	}
 
-	// Check if daily limit already reached
-	if state.MessagesSentToday >= cfg.MaxPerDay {
-		log.Warnw("daily message limit already reached",
-			"sent", state.MessagesSentToday,
-			"limit", cfg.MaxPerDay,
-		)
-		return fmt.Errorf("daily message limit reached: %d/%d", state.MessagesSentToday, cfg.MaxPerDay)
+	limiter := NewRateLimiter(cfg, state)
+	linkTracker := NewLinkTracker(store, cfg.LinkTrackingBaseURL)
+
+	// Check if the daily window is already exhausted before doing any work.
+	if ok, reason := limiter.Allow(time.Now()); !ok {
+		log.Warnw("rate limit already reached", "reason", reason)
+		sink.OnDailyLimitHit(MessageEvent{Reason: reason, At: time.Now()})
+		return 0, fmt.Errorf("rate limit reached: %s", reason)
 	}
 
 	successCount := 0
@@ -110,13 +148,17 @@ func SendFollowUps(
 	errorCount := 0
 
 	for i, profileURL := range profiles {
-		// Check daily limit before each attempt
-		if state.MessagesSentToday >= cfg.MaxPerDay {
-			log.Warnw("daily message limit reached during campaign",
-				"sent", state.MessagesSentToday,
-				"limit", cfg.MaxPerDay,
+		if ctx.Err() != nil {
+			return successCount, ctx.Err()
+		}
+
+		// Re-check the sliding windows (and any backoff) before each attempt.
+		if ok, reason := limiter.Allow(time.Now()); !ok {
+			log.Warnw("rate limit reached during campaign",
+				"reason", reason,
 				"remaining", len(profiles)-i,
 			)
+			sink.OnDailyLimitHit(MessageEvent{ProfileURL: profileURL, Reason: reason, At: time.Now()})
 			break
 		}
 
@@ -134,12 +176,11 @@ func SendFollowUps(
 			"index", i+1,
 			"total", len(profiles),
 			"sentToday", state.MessagesSentToday,
-			"limit", cfg.MaxPerDay,
 			"url", profileURL,
 		)
 
 		// Check if connection was accepted (has Message button)
-		isConnected, err := checkIfConnected(ctx, page, profileURL, log)
+		isConnected, err := checkIfConnected(ctx, page, profileURL, sink, log)
 		if err != nil {
 			log.Warnw("failed to check connection status",
 				"url", profileURL,
@@ -158,7 +199,7 @@ func SendFollowUps(
 		// Profile is connected, send follow-up message
 		log.Infow("profile is connected, sending follow-up message", "url", profileURL)
 
-		err = sendFollowUpMessage(ctx, page, profileURL, cfg, timingCfg, log)
+		trackedLinks, err := sendFollowUpMessage(ctx, page, profileURL, cfg, timingCfg, linkTracker, sink, log)
 
 		if err != nil {
 			log.Warnw("failed to send follow-up message",
@@ -172,16 +213,34 @@ func SendFollowUps(
 				Success:    false,
 			}
 			errorCount++
+
+			rateLimited := false
+			if body, bodyErr := page.Timeout(2 * time.Second).Element("body"); bodyErr == nil {
+				if bodyText, textErr := body.Text(); textErr == nil {
+					rateLimited = ContainsRateLimitWarning(bodyText)
+				}
+			}
+			if rateLimited {
+				log.Warnw("LinkedIn rate-limit warning detected, tripping back-off", "url", profileURL)
+				limiter.TripBackoff(time.Now())
+				sink.OnRateLimitDetected(MessageEvent{ProfileURL: profileURL, Reason: err.Error(), At: time.Now()})
+			} else {
+				limiter.RecordError(time.Now())
+				sink.OnMessageFailed(MessageEvent{ProfileURL: profileURL, Reason: err.Error(), At: time.Now()})
+			}
 		} else {
 			// Success
 			state.MessagesSentToday++
 			state.MessagedProfiles[profileURL] = MessageRecord{
-				ProfileURL:  profileURL,
-				Timestamp:   time.Now().Format(time.RFC3339),
-				MessageSent: cfg.MessageTemplate, // Store template, not personalized version
-				Success:     true,
+				ProfileURL:   profileURL,
+				Timestamp:    time.Now().Format(time.RFC3339),
+				MessageSent:  cfg.MessageTemplate, // Store template, not personalized version
+				Success:      true,
+				TrackedLinks: trackedLinks,
 			}
 			successCount++
+			limiter.RecordSend(time.Now())
+			sink.OnMessageSent(MessageEvent{ProfileURL: profileURL, At: time.Now()})
 
 			log.Infow("✅ follow-up message sent successfully",
 				"url", profileURL,
@@ -205,7 +264,9 @@ func SendFollowUps(
 			waitTime += stealth.RandomDelay(2000, 5000)
 
 			log.Infow("waiting before next message check", "duration", waitTime)
-			time.Sleep(waitTime)
+			if err := stealth.SleepCtx(ctx, waitTime); err != nil {
+				return successCount, err
+			}
 		}
 	}
 
@@ -218,7 +279,7 @@ func SendFollowUps(
 		"limit", cfg.MaxPerDay,
 	)
 
-	return nil
+	return successCount, nil
 }
 
 // checkIfConnected verifies if a connection request was accepted
@@ -236,21 +297,27 @@ func checkIfConnected(
 	ctx context.Context,
 	page *rod.Page,
 	profileURL string,
+	sink EventSink,
 	log *zap.SugaredLogger,
 ) (bool, error) {
 
 	// Navigate to profile
 	log.Debugw("navigating to profile to check connection status", "url", profileURL)
-	if err := page.Timeout(30 * time.Second).Navigate(profileURL); err != nil {
+	timedPage := page.Context(ctx).Timeout(30 * time.Second)
+	if err := timedPage.Navigate(profileURL); err != nil {
+		sink.OnMessageFailed(MessageEvent{ProfileURL: profileURL, Reason: err.Error(), At: time.Now()})
 		return false, fmt.Errorf("navigate to profile: %w", err)
 	}
 
-	if err := page.Timeout(30 * time.Second).WaitLoad(); err != nil {
+	if err := timedPage.WaitLoad(); err != nil {
+		sink.OnMessageFailed(MessageEvent{ProfileURL: profileURL, Reason: err.Error(), At: time.Now()})
 		return false, fmt.Errorf("wait for profile load: %w", err)
 	}
 
 	// Wait for profile to render
-	time.Sleep(3 * time.Second)
+	if err := stealth.SleepCtx(ctx, 3*time.Second); err != nil {
+		return false, err
+	}
 
 	// Check for Message button (indicates accepted connection)
 	messageButtonSelectors := []string{
@@ -320,58 +387,88 @@ func sendFollowUpMessage(
 	profileURL string,
 	cfg FollowUpConfig,
 	timingCfg config.TimingConfig,
+	linkTracker *LinkTracker,
+	sink EventSink,
 	log *zap.SugaredLogger,
-) error {
+) ([]TrackedLink, error) {
+	if sink == nil {
+		sink = noopEventSink{}
+	}
 
 	// Profile should already be loaded from checkIfConnected
 	// But ensure we're on the right page
 	currentURL := page.MustInfo().URL
 	if !strings.Contains(currentURL, profileURL) {
 		log.Debug("navigating to profile for messaging")
-		if err := page.Timeout(30 * time.Second).Navigate(profileURL); err != nil {
-			return fmt.Errorf("navigate to profile: %w", err)
+		timedPage := page.Context(ctx).Timeout(30 * time.Second)
+		if err := timedPage.Navigate(profileURL); err != nil {
+			return nil, fmt.Errorf("navigate to profile: %w", err)
 		}
-		if err := page.Timeout(30 * time.Second).WaitLoad(); err != nil {
-			return fmt.Errorf("wait for profile load: %w", err)
+		if err := timedPage.WaitLoad(); err != nil {
+			return nil, fmt.Errorf("wait for profile load: %w", err)
+		}
+		if err := stealth.SleepCtx(ctx, 2*time.Second); err != nil {
+			return nil, err
 		}
-		time.Sleep(2 * time.Second)
 	}
 
 	// Find Message button
-	messageBtn, err := findMessageButton(page, log)
+	messageBtn, err := findMessageButton(page, profileURL, sink, log)
 	if err != nil {
-		return fmt.Errorf("find message button: %w", err)
+		return nil, fmt.Errorf("find message button: %w", err)
 	}
 
 	// Human-like mouse movement to button
 	log.Debug("moving mouse to Message button")
-	if err := stealth.MoveToElementHuman(page, messageBtn, timingCfg); err != nil {
+	if err := stealth.MoveToElementHuman(ctx, page, messageBtn, timingCfg); err != nil {
 		log.Warnw("mouse movement to button failed, clicking directly", "error", err)
 	}
 
 	// Small hover pause (human thinking)
-	time.Sleep(stealth.RandomDelay(500, 1200))
+	if err := stealth.SleepCtx(ctx, stealth.RandomDelay(500, 1200)); err != nil {
+		return nil, err
+	}
 
 	// Click Message button
 	log.Info("clicking Message button")
 	if err := messageBtn.Click("left", 1); err != nil {
-		return fmt.Errorf("click message button: %w", err)
+		return nil, fmt.Errorf("click message button: %w", err)
 	}
 
 	// Wait for messaging interface to load
-	time.Sleep(3 * time.Second)
+	if err := stealth.SleepCtx(ctx, 3*time.Second); err != nil {
+		return nil, err
+	}
 
 	// Find message input textarea
-	textarea, err := findMessageInput(page, log)
+	textarea, err := findMessageInput(ctx, page, log)
 	if err != nil {
-		return fmt.Errorf("find message input: %w", err)
+		return nil, fmt.Errorf("find message input: %w", err)
 	}
 
-	// Extract first name from profile for personalization
-	firstName := extractFirstNameFromProfile(page, log)
+	// Extract personalization fields from the profile and render the template.
+	profileData := extractProfileData(page, log)
+	profileData.Context = cfg.Context
 
-	// Personalize message template
-	message := personalizeMessage(cfg.MessageTemplate, firstName, cfg.Context)
+	tmpl, err := CompileTemplate(cfg.MessageTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("compile message template: %w", err)
+	}
+	message, err := RenderMessage(tmpl, profileData)
+	if err != nil {
+		return nil, fmt.Errorf("render message: %w", err)
+	}
+
+	// Rewrite any URLs in the rendered message into click-tracked short
+	// links before anything gets typed, so the history we persist matches
+	// what the recipient actually received.
+	var trackedLinks []TrackedLink
+	if linkTracker != nil {
+		message, trackedLinks, err = linkTracker.Rewrite(ctx, message, profileURL)
+		if err != nil {
+			return nil, fmt.Errorf("rewrite tracked links: %w", err)
+		}
+	}
 
 	// Enforce message length limit
 	if len(message) > maxMessageLength {
@@ -382,47 +479,55 @@ func sendFollowUpMessage(
 	// Click textarea to focus
 	log.Debug("clicking message textarea")
 	if err := textarea.Click("left", 1); err != nil {
-		return fmt.Errorf("click textarea: %w", err)
+		return nil, fmt.Errorf("click textarea: %w", err)
 	}
 
-	time.Sleep(stealth.RandomDelay(500, 1000))
+	if err := stealth.SleepCtx(ctx, stealth.RandomDelay(500, 1000)); err != nil {
+		return nil, err
+	}
 
 	// Small "thinking" pause before typing (human behavior)
 	thinkPause := stealth.RandomDelay(1000, 3000)
 	log.Debugw("pausing before typing (thinking)", "duration", thinkPause)
-	time.Sleep(thinkPause)
+	if err := stealth.SleepCtx(ctx, thinkPause); err != nil {
+		return nil, err
+	}
 
 	// Type message with human-like behavior
 	log.Infow("typing follow-up message", "length", len(message))
-	if err := stealth.TypeHuman(textarea, message, timingCfg); err != nil {
-		return fmt.Errorf("type message: %w", err)
+	if err := stealth.TypeHuman(ctx, textarea, message, timingCfg); err != nil {
+		return nil, fmt.Errorf("type message: %w", err)
 	}
 
 	// Wait after typing (human reads what they typed)
 	reviewPause := stealth.RandomDelay(2000, 4000)
 	log.Debugw("pausing after typing (reviewing)", "duration", reviewPause)
-	time.Sleep(reviewPause)
+	if err := stealth.SleepCtx(ctx, reviewPause); err != nil {
+		return nil, err
+	}
 
 	// Find and click Send button
 	sendBtn, err := findSendButton(page, log)
 	if err != nil {
-		return fmt.Errorf("find send button: %w", err)
+		return nil, fmt.Errorf("find send button: %w", err)
 	}
 
 	log.Info("clicking Send button")
 	if err := sendBtn.Click("left", 1); err != nil {
-		return fmt.Errorf("click send button: %w", err)
+		return nil, fmt.Errorf("click send button: %w", err)
 	}
 
 	// Wait for message to send
-	time.Sleep(2 * time.Second)
+	if err := stealth.SleepCtx(ctx, 2*time.Second); err != nil {
+		return nil, err
+	}
 
 	log.Info("follow-up message sent successfully")
-	return nil
+	return trackedLinks, nil
 }
 
 // findMessageButton finds the Message button on a profile page
-func findMessageButton(page *rod.Page, log *zap.SugaredLogger) (*rod.Element, error) {
+func findMessageButton(page *rod.Page, profileURL string, sink EventSink, log *zap.SugaredLogger) (*rod.Element, error) {
 	// Multiple selectors for robustness
 	// LinkedIn's Message button can appear in different formats
 	messageSelectors := []string{
@@ -474,6 +579,9 @@ func findMessageButton(page *rod.Page, log *zap.SugaredLogger) (*rod.Element, er
 
 	for _, check := range restrictedReasons {
 		if _, err := page.Timeout(2 * time.Second).Element(check.selector); err == nil {
+			if check.reason == "messaging restricted" {
+				sink.OnMessagingRestricted(MessageEvent{ProfileURL: profileURL, Reason: check.reason, At: time.Now()})
+			}
 			return nil, fmt.Errorf("messaging unavailable: %s", check.reason)
 		}
 	}
@@ -489,9 +597,11 @@ func findMessageButton(page *rod.Page, log *zap.SugaredLogger) (*rod.Element, er
 // 2. Full messaging page (/messaging/thread/...)
 //
 // We handle both with multiple selectors
-func findMessageInput(page *rod.Page, log *zap.SugaredLogger) (*rod.Element, error) {
+func findMessageInput(ctx context.Context, page *rod.Page, log *zap.SugaredLogger) (*rod.Element, error) {
 	// Wait a bit for messaging interface to fully load
-	time.Sleep(2 * time.Second)
+	if err := stealth.SleepCtx(ctx, 2*time.Second); err != nil {
+		return nil, err
+	}
 
 	// Multiple selectors for message input
 	// Covers both modal and full messaging page
@@ -570,81 +680,6 @@ func findSendButton(page *rod.Page, log *zap.SugaredLogger) (*rod.Element, error
 	return nil, fmt.Errorf("send button not found")
 }
 
-// extractFirstNameFromProfile extracts the first name from a LinkedIn profile
-//
-// EXTRACTION STRATEGY:
-// 1. Look for h1 heading (main profile name)
-// 2. Extract full name
-// 3. Split on whitespace and take first word
-// 4. Fallback to "there" if extraction fails
-//
-// This is safe and defensive - never crashes on missing data
-func extractFirstNameFromProfile(page *rod.Page, log *zap.SugaredLogger) string {
-	// Selectors for profile name heading
-	nameSelectors := []string{
-		"h1.text-heading-xlarge",
-		"h1.inline.t-24",
-		"div.pv-text-details__left-panel h1",
-		"h1[class*='profile']",
-	}
-
-	for _, sel := range nameSelectors {
-		elem, err := page.Timeout(3 * time.Second).Element(sel)
-		if err != nil {
-			continue
-		}
-
-		fullName, err := elem.Text()
-		if err != nil || fullName == "" {
-			continue
-		}
-
-		// Extract first name
-		fullName = strings.TrimSpace(fullName)
-		parts := strings.Fields(fullName)
-		if len(parts) > 0 {
-			firstName := parts[0]
-			log.Debugw("extracted first name from profile", "name", firstName)
-			return firstName
-		}
-	}
-
-	log.Debug("could not extract first name, using fallback")
-	return "there" // Polite fallback
-}
-
-// personalizeMessage replaces template variables with actual values
-//
-// SUPPORTED VARIABLES:
-// - {{name}} -> first name
-// - {{context}} -> custom context (e.g., "software engineering", "your React work")
-//
-// DEFENSIVE BEHAVIOR:
-// - If variable missing, use fallback text
-// - If template malformed, return as-is
-// - Never crashes on bad input
-func personalizeMessage(template, firstName, context string) string {
-	message := template
-
-	// Replace {{name}} with first name
-	if firstName != "" {
-		message = strings.ReplaceAll(message, "{{name}}", firstName)
-	} else {
-		// Fallback: remove {{name}} or replace with generic greeting
-		message = strings.ReplaceAll(message, "{{name}}", "there")
-	}
-
-	// Replace {{context}} with provided context
-	if context != "" {
-		message = strings.ReplaceAll(message, "{{context}}", context)
-	} else {
-		// Fallback: remove {{context}} or replace with generic text
-		message = strings.ReplaceAll(message, "{{context}}", "your profile")
-	}
-
-	return message
-}
-
 // newMessageState creates a fresh message state
 func newMessageState() *MessageState {
 	return &MessageState{
@@ -656,7 +691,7 @@ func newMessageState() *MessageState {
 
 // loadMessageState loads message state from persistent storage
 func loadMessageState(ctx context.Context, store storage.StateStore, log *zap.SugaredLogger) (*MessageState, error) {
-	data, err := store.Load(ctx, stateKeyMessageState)
+	data, err := store.Load(ctx, StateKeyMessageState)
 	if err != nil {
 		return nil, fmt.Errorf("load state: %w", err)
 	}
@@ -691,7 +726,7 @@ func saveMessageState(ctx context.Context, store storage.StateStore, state *Mess
 		return fmt.Errorf("marshal state: %w", err)
 	}
 
-	if err := store.Save(ctx, stateKeyMessageState, data); err != nil {
+	if err := store.Save(ctx, StateKeyMessageState, data); err != nil {
 		return fmt.Errorf("save state: %w", err)
 	}
 
@@ -0,0 +1,95 @@
+package messaging
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-rod/rod"
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/config"
+)
+
+// LinkedInDMMessenger is the "linkedin_dm" channel: today's rod-driven,
+// check-then-send flow, wrapped behind the Messenger interface so Manager
+// can treat it the same as any other channel.
+type LinkedInDMMessenger struct {
+	Page      *rod.Page
+	Timing    config.TimingConfig
+	Log       *zap.SugaredLogger
+	// NotConnectedAfter is stored for callers; Push does not read it and
+	// returns ErrNotConnected on the first check that finds no accepted
+	// connection.
+	NotConnectedAfter time.Duration
+	// LinkTracker rewrites URLs in outgoing messages into click-tracked
+	// short links before they're typed. Nil disables rewriting.
+	LinkTracker *LinkTracker
+	// Sink is notified of send successes/failures/restrictions. Nil is
+	// equivalent to a no-op sink.
+	Sink EventSink
+
+	// firstSeen tracks when a profile was first found not-yet-connected,
+	// across repeated Push calls (see PendingSince).
+	firstSeen map[string]time.Time
+}
+
+// NewLinkedInDMMessenger builds a LinkedInDMMessenger over page.
+func NewLinkedInDMMessenger(page *rod.Page, timing config.TimingConfig, log *zap.SugaredLogger, notConnectedAfter time.Duration, linkTracker *LinkTracker, sink EventSink) *LinkedInDMMessenger {
+	if sink == nil {
+		sink = noopEventSink{}
+	}
+	return &LinkedInDMMessenger{
+		Page:              page,
+		Timing:            timing,
+		Log:               log,
+		NotConnectedAfter: notConnectedAfter,
+		LinkTracker:       linkTracker,
+		Sink:              sink,
+		firstSeen:         make(map[string]time.Time),
+	}
+}
+
+func (m *LinkedInDMMessenger) Name() string { return "linkedin_dm" }
+
+// Push checks the profile for an accepted connection and, if present, sends
+// the templated message via the existing DOM flow. If the connection is not
+// accepted yet, it records when the profile was first seen pending and
+// returns ErrNotConnected right away so Manager can fall back to the next
+// channel; how long the invite has been pending is not considered.
+func (m *LinkedInDMMessenger) Push(msg Message) error {
+	ctx := context.Background()
+
+	connected, err := checkIfConnected(ctx, m.Page, msg.ProfileURL, m.Sink, m.Log)
+	if err != nil {
+		return err
+	}
+
+	if !connected {
+		if _, seen := m.firstSeen[msg.ProfileURL]; !seen {
+			m.firstSeen[msg.ProfileURL] = time.Now()
+		}
+		return ErrNotConnected
+	}
+
+	delete(m.firstSeen, msg.ProfileURL)
+
+	cfg := FollowUpConfig{
+		MessageTemplate: msg.Template,
+		Context:         msg.Context,
+	}
+	_, err = sendFollowUpMessage(ctx, m.Page, msg.ProfileURL, cfg, m.Timing, m.LinkTracker, m.Sink, m.Log)
+	return err
+}
+
+// PendingSince reports when profileURL was first observed not-yet-connected.
+// Manager does not call it.
+func (m *LinkedInDMMessenger) PendingSince(profileURL string) (time.Time, bool) {
+	t, ok := m.firstSeen[profileURL]
+	return t, ok
+}
+
+// Flush is a no-op: each Push already sends synchronously.
+func (m *LinkedInDMMessenger) Flush() error { return nil }
+
+// Close is a no-op: the browser/page lifecycle is owned by the caller.
+func (m *LinkedInDMMessenger) Close() error { return nil }
@@ -0,0 +1,140 @@
+package messaging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"linkedin-automation-tool/storage"
+)
+
+const trackedLinkKeyPrefix = "link:"
+
+var urlPattern = regexp.MustCompile(`https?://[^\s)]+`)
+
+// TrackedLink is one URL rewritten inside a campaign message, registered in
+// the state store so the tracker HTTP handler (see messaging/tracker) can
+// resolve a click back to the recipient.
+type TrackedLink struct {
+	ID          string `json:"id"`
+	OriginalURL string `json:"original_url"`
+	ProfileURL  string `json:"profile_url"`
+	CreatedAt   string `json:"created_at"` // RFC3339
+	Clicked     bool   `json:"clicked"`
+	ClickedAt   string `json:"clicked_at,omitempty"` // RFC3339
+}
+
+// LinkTracker rewrites URLs inside outbound message bodies into short,
+// per-recipient tracked links (`{BaseURL}/l/{id}`), following listmonk's
+// CreateLink pattern: the mapping is what's persisted, not the message
+// itself, so click-through can be measured independently of delivery.
+type LinkTracker struct {
+	store   storage.StateStore
+	baseURL string
+}
+
+// NewLinkTracker builds a LinkTracker. baseURL defaults to
+// "https://l.example.com" when empty (a placeholder until a real redirect
+// domain is configured).
+func NewLinkTracker(store storage.StateStore, baseURL string) *LinkTracker {
+	if baseURL == "" {
+		baseURL = "https://l.example.com"
+	}
+	return &LinkTracker{store: store, baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+// Rewrite scans body for URLs, registers each with a new TrackedLink, and
+// returns the body with every URL replaced by its tracked short link.
+// Repeats of the same URL within one body share a single TrackedLink.
+func (t *LinkTracker) Rewrite(ctx context.Context, body, profileURL string) (string, []TrackedLink, error) {
+	matches := urlPattern.FindAllString(body, -1)
+	if len(matches) == 0 {
+		return body, nil, nil
+	}
+
+	rewritten := body
+	shortFor := make(map[string]string, len(matches))
+	links := make([]TrackedLink, 0, len(matches))
+
+	for _, original := range matches {
+		if _, done := shortFor[original]; done {
+			continue
+		}
+
+		id, err := randomLinkID()
+		if err != nil {
+			return "", nil, fmt.Errorf("generate link id: %w", err)
+		}
+
+		link := TrackedLink{
+			ID:          id,
+			OriginalURL: original,
+			ProfileURL:  profileURL,
+			CreatedAt:   time.Now().Format(time.RFC3339),
+		}
+		if err := t.save(ctx, link); err != nil {
+			return "", nil, err
+		}
+
+		short := fmt.Sprintf("%s/l/%s", t.baseURL, id)
+		shortFor[original] = short
+		links = append(links, link)
+		rewritten = strings.ReplaceAll(rewritten, original, short)
+	}
+
+	return rewritten, links, nil
+}
+
+// Resolve loads the TrackedLink for id.
+func (t *LinkTracker) Resolve(ctx context.Context, id string) (*TrackedLink, error) {
+	raw, err := t.store.Load(ctx, trackedLinkKeyPrefix+id)
+	if err != nil {
+		return nil, fmt.Errorf("load tracked link %s: %w", id, err)
+	}
+	var link TrackedLink
+	if err := json.Unmarshal(raw, &link); err != nil {
+		return nil, fmt.Errorf("unmarshal tracked link %s: %w", id, err)
+	}
+	return &link, nil
+}
+
+// RecordClick marks id as clicked. Safe to call more than once; only the
+// first click timestamp is kept.
+func (t *LinkTracker) RecordClick(ctx context.Context, id string) error {
+	link, err := t.Resolve(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !link.Clicked {
+		link.Clicked = true
+		link.ClickedAt = time.Now().Format(time.RFC3339)
+		if err := t.save(ctx, *link); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *LinkTracker) save(ctx context.Context, link TrackedLink) error {
+	raw, err := json.Marshal(link)
+	if err != nil {
+		return fmt.Errorf("marshal tracked link: %w", err)
+	}
+	if err := t.store.Save(ctx, trackedLinkKeyPrefix+link.ID, raw); err != nil {
+		return fmt.Errorf("save tracked link: %w", err)
+	}
+	return nil
+}
+
+func randomLinkID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,213 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ManagerConfig tunes the scheduler/worker pool Manager runs.
+type ManagerConfig struct {
+	// SchedulerInterval is how often due campaigns are polled.
+	SchedulerInterval time.Duration
+	// ProfileBatchSize is how many profiles NextProfiles pulls per campaign
+	// per scheduler tick.
+	ProfileBatchSize int
+	// Workers is how many goroutines drain the message queue.
+	Workers int
+}
+
+// queuedMessage pairs a Message with the campaign's configured fallback
+// chain so a worker can walk Channels in order.
+type queuedMessage struct {
+	msg      Message
+	channels []string
+}
+
+// Manager is a long-running, multi-campaign follow-up engine: a scheduler
+// goroutine pulls due campaigns from DataSource, a fetch goroutine fills
+// per-campaign profile batches, and worker goroutines drain the resulting
+// message queue through the right Messenger for each channel. It replaces
+// the single-shot SendFollowUps loop for deployments running more than one
+// campaign or channel.
+type Manager struct {
+	ds         DataSource
+	messengers map[string]Messenger
+	cfg        ManagerConfig
+	log        *zap.SugaredLogger
+
+	profileFetchQueue chan Campaign
+	msgQueue          chan queuedMessage
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewManager builds a Manager. messengers is keyed by channel name (the
+// same names campaigns list in Channels); a channel with no registered
+// Messenger is logged and skipped, and the next channel in the list is tried.
+func NewManager(ds DataSource, messengers map[string]Messenger, cfg ManagerConfig, log *zap.SugaredLogger) *Manager {
+	if cfg.SchedulerInterval <= 0 {
+		cfg.SchedulerInterval = time.Minute
+	}
+	if cfg.ProfileBatchSize <= 0 {
+		cfg.ProfileBatchSize = 20
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = 2
+	}
+
+	return &Manager{
+		ds:                ds,
+		messengers:        messengers,
+		cfg:               cfg,
+		log:               log,
+		profileFetchQueue: make(chan Campaign, 32),
+		msgQueue:          make(chan queuedMessage, 256),
+		stop:              make(chan struct{}),
+	}
+}
+
+// Start launches the scheduler, the profile-fetch loop, and the worker
+// pool. Call Stop to shut them down.
+func (m *Manager) Start(ctx context.Context) {
+	m.wg.Add(2 + m.cfg.Workers)
+
+	go m.runScheduler(ctx)
+	go m.runProfileFetcher(ctx)
+	for i := 0; i < m.cfg.Workers; i++ {
+		go m.runWorker(ctx)
+	}
+}
+
+// Stop signals all goroutines to exit, waits for them to drain, then closes
+// every registered Messenger.
+func (m *Manager) Stop() {
+	close(m.stop)
+	m.wg.Wait()
+	for channel, messenger := range m.messengers {
+		if err := messenger.Close(); err != nil {
+			m.log.Warnw("messenger close failed", "channel", channel, "error", err)
+		}
+	}
+}
+
+func (m *Manager) runScheduler(ctx context.Context) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.cfg.SchedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			due, err := m.ds.NextCampaigns(ctx)
+			if err != nil {
+				m.log.Warnw("scheduler failed to list due campaigns", "error", err)
+				continue
+			}
+			for _, camp := range due {
+				select {
+				case m.profileFetchQueue <- camp:
+				default:
+					m.log.Warnw("profile fetch queue full, dropping campaign this tick", "campaignID", camp.ID)
+				}
+			}
+		}
+	}
+}
+
+func (m *Manager) runProfileFetcher(ctx context.Context) {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ctx.Done():
+			return
+		case camp := <-m.profileFetchQueue:
+			m.fetchProfiles(ctx, camp)
+		}
+	}
+}
+
+func (m *Manager) fetchProfiles(ctx context.Context, camp Campaign) {
+	profiles, err := m.ds.NextProfiles(ctx, camp.ID, m.cfg.ProfileBatchSize)
+	if err != nil {
+		m.log.Warnw("failed to fetch profiles for campaign", "campaignID", camp.ID, "error", err)
+		return
+	}
+
+	if len(profiles) == 0 {
+		if err := m.ds.UpdateCampaignStatus(ctx, camp.ID, "done"); err != nil {
+			m.log.Warnw("failed to mark campaign done", "campaignID", camp.ID, "error", err)
+		}
+		return
+	}
+
+	for _, profileURL := range profiles {
+		qm := queuedMessage{
+			msg: Message{
+				CampaignID: camp.ID,
+				ProfileURL: profileURL,
+				Template:   camp.MessageTemplate,
+				Context:    camp.Context,
+			},
+			channels: camp.Channels,
+		}
+		select {
+		case m.msgQueue <- qm:
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+func (m *Manager) runWorker(ctx context.Context) {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ctx.Done():
+			return
+		case qm := <-m.msgQueue:
+			m.deliver(qm)
+		}
+	}
+}
+
+// deliver walks qm's channel fallback chain, stopping at the first
+// Messenger that accepts the message. ErrNotConnected is the only error
+// that advances to the next channel; any other error is logged and dropped
+// so a bad profile doesn't block the rest of the queue.
+func (m *Manager) deliver(qm queuedMessage) {
+	for _, channel := range qm.channels {
+		messenger, ok := m.messengers[channel]
+		if !ok {
+			m.log.Warnw("no messenger registered for channel", "channel", channel, "campaignID", qm.msg.CampaignID)
+			continue
+		}
+
+		err := messenger.Push(qm.msg)
+		if err == nil {
+			return
+		}
+		if errors.Is(err, ErrNotConnected) {
+			m.log.Debugw("not connected yet, trying next channel", "channel", channel, "profileURL", qm.msg.ProfileURL)
+			continue
+		}
+
+		m.log.Warnw("messenger push failed", "channel", channel, "profileURL", qm.msg.ProfileURL, "error", err)
+		return
+	}
+}
@@ -0,0 +1,37 @@
+package messaging
+
+import "errors"
+
+// ErrNotConnected signals that the recipient hasn't accepted the connection
+// yet, which Manager treats as a cue to fall back to the next channel in a
+// campaign's Channels list.
+var ErrNotConnected = errors.New("messaging: recipient not connected")
+
+// ErrNotImplemented is returned by stub Messengers that declare a channel
+// name for config purposes but don't yet send anything.
+var ErrNotImplemented = errors.New("messaging: channel not implemented")
+
+// Message is one piece of outbound campaign mail, addressed to a profile.
+type Message struct {
+	CampaignID string
+	ProfileURL string
+	Template   string
+	Context    string
+}
+
+// Messenger is a channel-specific delivery backend. Manager looks one up by
+// name per campaign/channel and calls Push for each due message.
+type Messenger interface {
+	// Name identifies the channel, matching the name used in a Campaign's
+	// Channels list (e.g. "linkedin_dm", "inmail", "email").
+	Name() string
+	// Push delivers msg. Returning ErrNotConnected lets Manager try the
+	// next channel in the campaign's fallback chain instead of failing it.
+	Push(msg Message) error
+	// Flush gives batching Messengers (e.g. an email backend queuing a
+	// digest) a chance to send what they're holding.
+	Flush() error
+	// Close releases any resources the Messenger opened (SMTP connections,
+	// API clients). Manager calls it once on shutdown.
+	Close() error
+}
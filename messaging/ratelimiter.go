@@ -0,0 +1,175 @@
+package messaging
+
+import (
+	"strings"
+	"time"
+)
+
+// rateLimitWarningStrings are DOM phrases LinkedIn shows when it's actively
+// throttling an account (as opposed to a plain network/selector error).
+// Seeing one of these is treated as a much stronger signal than an ordinary
+// errorCount bump.
+var rateLimitWarningStrings = []string{
+	"you've reached the weekly invitation limit",
+	"you have reached the limit",
+	"try again later",
+	"unusual activity",
+	"restricted",
+}
+
+// ContainsRateLimitWarning reports whether pageText (profile/page body text)
+// contains one of LinkedIn's known throttling messages.
+func ContainsRateLimitWarning(pageText string) bool {
+	lower := strings.ToLower(pageText)
+	for _, s := range rateLimitWarningStrings {
+		if strings.Contains(lower, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// BackoffState is the adaptive cool-down RateLimiter persists across runs.
+// Tripping it halves the effective per-window caps until CooldownUntil
+// passes; repeated trips double the cooldown duration (capped) instead of
+// resetting to the base duration, so a chronically-flagged account keeps
+// backing off rather than retrying at the same cadence forever.
+type BackoffState struct {
+	ConsecutiveErrors int       `json:"consecutive_errors"`
+	Level             int       `json:"level"`          // doubles the cooldown duration each trip
+	CooldownUntil     time.Time `json:"cooldown_until"` // zero value means "not cooling down"
+}
+
+const (
+	// errorSpikeThreshold is how many consecutive send errors trip backoff.
+	errorSpikeThreshold = 3
+	baseCooldown        = 1 * time.Hour
+	maxCooldownLevel    = 6 // base * 2^5 = 32h ceiling
+)
+
+// active reports whether now is still inside the cool-down window.
+func (b BackoffState) active(now time.Time) bool {
+	return !b.CooldownUntil.IsZero() && now.Before(b.CooldownUntil)
+}
+
+// RateLimiter enforces per-minute/hour/day/week sliding-window send caps
+// plus a minimum gap between sends, backed by the RFC3339 timestamp ring
+// buffer in MessageState. Unlike a fixed midnight-reset daily counter, a
+// sliding window can't be gamed by sending the day's quota right at 00:00
+// and again right before the next reset.
+type RateLimiter struct {
+	cfg   FollowUpConfig
+	state *MessageState
+}
+
+// NewRateLimiter builds a RateLimiter over state, which it mutates in place
+// (callers persist state via saveMessageState as before).
+func NewRateLimiter(cfg FollowUpConfig, state *MessageState) *RateLimiter {
+	return &RateLimiter{cfg: cfg, state: state}
+}
+
+// Allow reports whether a send is permitted right now. On false it also
+// returns a short human-readable reason for logging.
+func (r *RateLimiter) Allow(now time.Time) (bool, string) {
+	if r.cfg.MinGapBetweenSends > 0 {
+		if last, ok := r.lastSend(); ok && now.Sub(last) < r.cfg.MinGapBetweenSends {
+			return false, "minimum gap between sends not yet elapsed"
+		}
+	}
+
+	factor := 1
+	if r.state.Backoff.active(now) {
+		// Cooling down after repeated errors/rate-limit warnings: halve the
+		// effective caps rather than refusing outright, so a legitimate
+		// slow trickle of sends can still get through.
+		factor = 2
+	}
+
+	if r.cfg.MaxPerDay > 0 && r.countSince(now.Add(-24*time.Hour)) >= r.cfg.MaxPerDay/factor {
+		return false, "per-day cap reached"
+	}
+	if r.cfg.MaxPerHour > 0 && r.countSince(now.Add(-time.Hour)) >= r.cfg.MaxPerHour/factor {
+		return false, "per-hour cap reached"
+	}
+	if r.cfg.MaxPerWeek > 0 && r.countSince(now.Add(-7*24*time.Hour)) >= r.cfg.MaxPerWeek/factor {
+		return false, "per-week cap reached"
+	}
+	if r.countSince(now.Add(-time.Minute)) >= 1 && r.cfg.MinGapBetweenSends == 0 {
+		// Without an explicit MinGapBetweenSends, still refuse to send more
+		// than once per minute; sliding windows alone don't prevent bursts.
+		return false, "per-minute cap reached"
+	}
+
+	return true, ""
+}
+
+// RecordSend appends now to the timestamp ring buffer, prunes entries older
+// than a week (the widest window RateLimiter tracks), and clears the
+// consecutive-error counter since a successful send means we're not stuck.
+func (r *RateLimiter) RecordSend(now time.Time) {
+	r.state.SendTimestamps = append(r.state.SendTimestamps, now.Format(time.RFC3339))
+	r.pruneOlderThan(now.Add(-7 * 24 * time.Hour))
+	r.state.Backoff.ConsecutiveErrors = 0
+}
+
+// RecordError bumps the consecutive-error counter and trips backoff once it
+// reaches errorSpikeThreshold.
+func (r *RateLimiter) RecordError(now time.Time) {
+	r.state.Backoff.ConsecutiveErrors++
+	if r.state.Backoff.ConsecutiveErrors >= errorSpikeThreshold {
+		r.TripBackoff(now)
+	}
+}
+
+// TripBackoff forces an immediate cool-down, doubling the cooldown duration
+// from the previous trip (up to maxCooldownLevel). Callers use this
+// directly when ContainsRateLimitWarning fires, since that's a stronger
+// signal than an ordinary error and shouldn't wait for the error-spike
+// threshold.
+func (r *RateLimiter) TripBackoff(now time.Time) {
+	level := r.state.Backoff.Level
+	if level < maxCooldownLevel {
+		level++
+	}
+	cooldown := baseCooldown * time.Duration(1<<uint(level-1))
+
+	r.state.Backoff.Level = level
+	r.state.Backoff.ConsecutiveErrors = 0
+	r.state.Backoff.CooldownUntil = now.Add(cooldown)
+}
+
+func (r *RateLimiter) lastSend() (time.Time, bool) {
+	if len(r.state.SendTimestamps) == 0 {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, r.state.SendTimestamps[len(r.state.SendTimestamps)-1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func (r *RateLimiter) countSince(cutoff time.Time) int {
+	count := 0
+	for _, raw := range r.state.SendTimestamps {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			continue
+		}
+		if t.After(cutoff) {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *RateLimiter) pruneOlderThan(cutoff time.Time) {
+	kept := r.state.SendTimestamps[:0]
+	for _, raw := range r.state.SendTimestamps {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err == nil && t.After(cutoff) {
+			kept = append(kept, raw)
+		}
+	}
+	r.state.SendTimestamps = kept
+}
@@ -0,0 +1,22 @@
+package messaging
+
+// InMailMessenger declares the "inmail" channel so campaigns can reference
+// it in config, but doesn't send anything yet: LinkedIn InMail requires a
+// Sales Navigator/Recruiter seat and its own API integration, which isn't
+// wired up in this tool.
+type InMailMessenger struct{}
+
+func (InMailMessenger) Name() string      { return "inmail" }
+func (InMailMessenger) Push(Message) error { return ErrNotImplemented }
+func (InMailMessenger) Flush() error       { return nil }
+func (InMailMessenger) Close() error       { return nil }
+
+// EmailMessenger declares the "email" channel for campaigns that want a
+// fallback once a LinkedIn invite goes stale, but doesn't send yet: it
+// needs an SMTP/transactional-email config this tool doesn't have.
+type EmailMessenger struct{}
+
+func (EmailMessenger) Name() string      { return "email" }
+func (EmailMessenger) Push(Message) error { return ErrNotImplemented }
+func (EmailMessenger) Flush() error       { return nil }
+func (EmailMessenger) Close() error       { return nil }
@@ -0,0 +1,120 @@
+package messaging
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/go-rod/rod"
+	"go.uber.org/zap"
+)
+
+// ProfileData is the per-recipient context exposed to message templates. It
+// extends the old first-name-only personalization with everything
+// extractProfileData can pull off a profile page, so templates can write
+// things like `{{.Headline | truncate 40}}` or
+// `{{if .MutualConnections}}we both know {{.MutualConnections | first}}{{end}}`.
+type ProfileData struct {
+	FirstName         string
+	LastName          string
+	Headline          string
+	Company           string
+	Location          string
+	MutualConnections []string
+	// Context carries the campaign-level Context string forward as
+	// {{.Context}}, replacing the old {{context}} substitution.
+	Context string
+}
+
+// CompileTemplate parses tmplText with the sprig function library, the same
+// approach listmonk uses for campaign bodies: compile once per
+// campaign/config rather than re-parsing per recipient. Callers should do
+// this at config/campaign-load time so a malformed template fails before
+// any browser automation starts.
+func CompileTemplate(tmplText string) (*template.Template, error) {
+	tmpl, err := template.New("message").Funcs(sprig.TxtFuncMap()).Parse(tmplText)
+	if err != nil {
+		return nil, fmt.Errorf("parse message template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// RenderMessage executes tmpl against data.
+func RenderMessage(tmpl *template.Template, data ProfileData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("render message template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// extractProfileData pulls personalization fields off the currently loaded
+// profile page. Each field is best-effort and defensive: a missing selector
+// yields a zero value rather than an error, same philosophy as the
+// first-name-only extraction this replaces.
+func extractProfileData(page *rod.Page, log *zap.SugaredLogger) ProfileData {
+	var data ProfileData
+
+	fullName := textFromSelectors(page, []string{
+		"h1.text-heading-xlarge",
+		"h1.inline.t-24",
+		"div.pv-text-details__left-panel h1",
+		"h1[class*='profile']",
+	})
+	if fullName != "" {
+		parts := strings.Fields(fullName)
+		data.FirstName = parts[0]
+		if len(parts) > 1 {
+			data.LastName = strings.Join(parts[1:], " ")
+		}
+	} else {
+		log.Debug("could not extract name from profile")
+	}
+
+	data.Headline = textFromSelectors(page, []string{
+		"div.text-body-medium.break-words",
+		"div.pv-text-details__left-panel div.text-body-medium",
+	})
+
+	data.Company = textFromSelectors(page, []string{
+		"button[aria-label*='Current company']",
+		"span.pv-text-details__right-panel a",
+	})
+
+	data.Location = textFromSelectors(page, []string{
+		"span.text-body-small.inline.t-black--light.break-words",
+	})
+
+	if mutual := textFromSelectors(page, []string{
+		"a[href*='facepile'] span",
+		"span.t-12.t-black--light.t-normal",
+	}); mutual != "" {
+		for _, name := range strings.Split(mutual, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				data.MutualConnections = append(data.MutualConnections, name)
+			}
+		}
+	}
+
+	return data
+}
+
+// textFromSelectors returns the trimmed text of the first selector that
+// matches, or "" if none do.
+func textFromSelectors(page *rod.Page, selectors []string) string {
+	for _, sel := range selectors {
+		elem, err := page.Timeout(3 * time.Second).Element(sel)
+		if err != nil {
+			continue
+		}
+		text, err := elem.Text()
+		if err != nil || text == "" {
+			continue
+		}
+		return strings.TrimSpace(text)
+	}
+	return ""
+}
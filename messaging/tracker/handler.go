@@ -0,0 +1,49 @@
+// Package tracker serves the click-tracking redirect endpoint for links
+// rewritten by messaging.LinkTracker.
+package tracker
+
+import (
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/messaging"
+)
+
+// Handler serves GET /l/{id}: it looks up the original URL via
+// messaging.LinkTracker, records the click against the recipient profile,
+// and 302-redirects the visitor there.
+type Handler struct {
+	tracker *messaging.LinkTracker
+	log     *zap.SugaredLogger
+}
+
+// NewHandler builds a Handler backed by tracker.
+func NewHandler(tracker *messaging.LinkTracker, log *zap.SugaredLogger) *Handler {
+	return &Handler{tracker: tracker, log: log}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/l/")
+	if id == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	ctx := r.Context()
+	link, err := h.tracker.Resolve(ctx, id)
+	if err != nil {
+		h.log.Warnw("tracked link not found", "id", id, "error", err)
+		http.NotFound(w, r)
+		return
+	}
+
+	if err := h.tracker.RecordClick(ctx, id); err != nil {
+		h.log.Warnw("failed to record click", "id", id, "error", err)
+	} else {
+		h.log.Infow("tracked link clicked", "id", id, "profileURL", link.ProfileURL)
+	}
+
+	http.Redirect(w, r, link.OriginalURL, http.StatusFound)
+}
@@ -0,0 +1,83 @@
+package messaging
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WebhookSink POSTs a JSON payload to URL for every event, signing the body
+// with an HMAC-SHA256 in the X-Signature header when Secret is set, the
+// same shape GitHub/Stripe use for their outgoing webhooks.
+type WebhookSink struct {
+	URL    string
+	Secret string
+	Client *http.Client
+	Log    *zap.SugaredLogger
+}
+
+// NewWebhookSink builds a WebhookSink with a sane request timeout.
+func NewWebhookSink(url, secret string, log *zap.SugaredLogger) *WebhookSink {
+	return &WebhookSink{
+		URL:    url,
+		Secret: secret,
+		Client: &http.Client{Timeout: 10 * time.Second},
+		Log:    log,
+	}
+}
+
+type webhookPayload struct {
+	Type       string    `json:"type"`
+	ProfileURL string    `json:"profile_url,omitempty"`
+	CampaignID string    `json:"campaign_id,omitempty"`
+	Reason     string    `json:"reason,omitempty"`
+	At         time.Time `json:"at"`
+}
+
+func (w *WebhookSink) deliver(eventType string, evt MessageEvent) {
+	body, err := json.Marshal(webhookPayload{
+		Type:       eventType,
+		ProfileURL: evt.ProfileURL,
+		CampaignID: evt.CampaignID,
+		Reason:     evt.Reason,
+		At:         evt.At,
+	})
+	if err != nil {
+		w.Log.Warnw("marshal webhook payload failed", "event", eventType, "error", err)
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		w.Log.Warnw("build webhook request failed", "event", eventType, "error", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(w.Secret))
+		mac.Write(body)
+		req.Header.Set("X-Signature", hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		w.Log.Warnw("webhook delivery failed", "event", eventType, "error", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		w.Log.Warnw("webhook endpoint returned non-2xx", "event", eventType, "status", resp.StatusCode)
+	}
+}
+
+func (w *WebhookSink) OnMessageSent(evt MessageEvent)         { w.deliver("message_sent", evt) }
+func (w *WebhookSink) OnMessageFailed(evt MessageEvent)       { w.deliver("message_failed", evt) }
+func (w *WebhookSink) OnDailyLimitHit(evt MessageEvent)       { w.deliver("daily_limit_hit", evt) }
+func (w *WebhookSink) OnRateLimitDetected(evt MessageEvent)   { w.deliver("rate_limit_detected", evt) }
+func (w *WebhookSink) OnMessagingRestricted(evt MessageEvent) { w.deliver("messaging_restricted", evt) }
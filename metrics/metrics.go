@@ -0,0 +1,145 @@
+// Package metrics exposes Prometheus collectors for automation activity and
+// quotas, scraped over HTTP from a configurable port (see Serve).
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/storage"
+)
+
+// QuotaLimits tells the quota gauge how to interpret the remaining quota
+// once it has loaded the current counts from storage.
+type QuotaLimits struct {
+	MaxConnectionsPerDay int
+	MaxMessagesPerDay    int
+}
+
+// Collector registers and updates the counters, gauges, and histograms that
+// track automation activity. A nil *Collector is safe to call every method
+// on - they all no-op - so call sites don't need a separate code path for
+// metrics.enabled=false.
+type Collector struct {
+	registry *prometheus.Registry
+
+	profilesFound          prometheus.Counter
+	connectionRequestsSent *prometheus.CounterVec
+	messagesSent           prometheus.Counter
+	navigationFailures     *prometheus.CounterVec
+	actionLatency          *prometheus.HistogramVec
+	tabScrollDuration      *prometheus.HistogramVec
+}
+
+// New builds a Collector on its own registry (rather than the global
+// prometheus.DefaultRegisterer) and registers a scrape-driven quota
+// collector alongside it: the quota gauge reads connect/messaging state
+// from store on every scrape rather than trusting in-memory counters,
+// mirroring cf_exporter's fetcher-backed collector pattern, so quotas
+// survive process restarts.
+func New(store storage.StateStore, limits QuotaLimits, log *zap.SugaredLogger) *Collector {
+	reg := prometheus.NewRegistry()
+
+	c := &Collector{
+		registry: reg,
+		profilesFound: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "linkedin_profiles_found_total",
+			Help: "Total profiles found by people search.",
+		}),
+		connectionRequestsSent: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "linkedin_connection_requests_sent_total",
+			Help: "Total connection requests attempted, labeled by result (sent, failed, skipped).",
+		}, []string{"result"}),
+		messagesSent: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "linkedin_messages_sent_total",
+			Help: "Total follow-up messages sent successfully.",
+		}),
+		navigationFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "linkedin_navigation_failures_total",
+			Help: "Total tab navigation failures, labeled by tab.",
+		}, []string{"tab"}),
+		actionLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "linkedin_action_latency_seconds",
+			Help:    "Latency of top-level automation actions, labeled by action (search, connect, message, navigate).",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"action"}),
+		tabScrollDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "linkedin_tab_scroll_duration_seconds",
+			Help:    "Wall-clock time spent scrolling each tab, labeled by tab.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"tab"}),
+	}
+
+	reg.MustRegister(
+		c.profilesFound,
+		c.connectionRequestsSent,
+		c.messagesSent,
+		c.navigationFailures,
+		c.actionLatency,
+		c.tabScrollDuration,
+		newQuotaCollector(store, limits, log),
+	)
+
+	return c
+}
+
+// Registry exposes the registry New registered its collectors on, for
+// Serve to hand to promhttp.
+func (c *Collector) Registry() *prometheus.Registry {
+	if c == nil {
+		return nil
+	}
+	return c.registry
+}
+
+// AddProfilesFound increments linkedin_profiles_found_total by n.
+func (c *Collector) AddProfilesFound(n int) {
+	if c == nil || n <= 0 {
+		return
+	}
+	c.profilesFound.Add(float64(n))
+}
+
+// AddConnectionRequests increments linkedin_connection_requests_sent_total by
+// n for the given result ("sent", "failed", or "skipped").
+func (c *Collector) AddConnectionRequests(result string, n int) {
+	if c == nil || n <= 0 {
+		return
+	}
+	c.connectionRequestsSent.WithLabelValues(result).Add(float64(n))
+}
+
+// AddMessagesSent increments linkedin_messages_sent_total by n.
+func (c *Collector) AddMessagesSent(n int) {
+	if c == nil || n <= 0 {
+		return
+	}
+	c.messagesSent.Add(float64(n))
+}
+
+// ObserveNavigationFailure increments linkedin_navigation_failures_total for
+// the given tab.
+func (c *Collector) ObserveNavigationFailure(tab string) {
+	if c == nil {
+		return
+	}
+	c.navigationFailures.WithLabelValues(tab).Inc()
+}
+
+// ObserveActionLatency records how long a top-level action took.
+func (c *Collector) ObserveActionLatency(action string, d time.Duration) {
+	if c == nil {
+		return
+	}
+	c.actionLatency.WithLabelValues(action).Observe(d.Seconds())
+}
+
+// ObserveTabScrollDuration records how long scrolling a tab took.
+func (c *Collector) ObserveTabScrollDuration(tab string, d time.Duration) {
+	if c == nil {
+		return
+	}
+	c.tabScrollDuration.WithLabelValues(tab).Observe(d.Seconds())
+}
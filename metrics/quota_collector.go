@@ -0,0 +1,142 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/connect"
+	"linkedin-automation-tool/messaging"
+	"linkedin-automation-tool/storage"
+)
+
+var dailyQuotaRemainingDesc = prometheus.NewDesc(
+	"linkedin_daily_quota_remaining",
+	"Remaining daily quota before automation stops for the day, labeled by action (connection_request, message).",
+	[]string{"action"},
+	nil,
+)
+
+// quotaCollector is a fetcher-style prometheus.Collector: instead of
+// tracking quota in memory, Collect reads the same connect.ConnectionState
+// and messaging.MessageState blobs the campaigns themselves persist, so the
+// gauge stays correct across process restarts and reflects whatever backend
+// storage.StateStore currently points at.
+type quotaCollector struct {
+	store  storage.StateStore
+	limits QuotaLimits
+	log    *zap.SugaredLogger
+}
+
+func newQuotaCollector(store storage.StateStore, limits QuotaLimits, log *zap.SugaredLogger) prometheus.Collector {
+	return &quotaCollector{store: store, limits: limits, log: log}
+}
+
+func (q *quotaCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- dailyQuotaRemainingDesc
+}
+
+func (q *quotaCollector) Collect(ch chan<- prometheus.Metric) {
+	remaining := RemainingToday(context.Background(), q.store, q.limits, q.log)
+	for action, n := range remaining {
+		ch <- prometheus.MustNewConstMetric(dailyQuotaRemainingDesc, prometheus.GaugeValue, float64(n), action)
+	}
+}
+
+// RemainingToday returns how many of each quota-limited action remain today,
+// keyed by action ("connection_request", "message") - the same view
+// quotaCollector exposes as a gauge, for callers (e.g. the control-plane API
+// and TUI) that want the numbers directly instead of scraping /metrics.
+func RemainingToday(ctx context.Context, store storage.StateStore, limits QuotaLimits, log *zap.SugaredLogger) map[string]int {
+	q := &quotaCollector{store: store, limits: limits, log: log}
+	today := time.Now().Format("2006-01-02")
+	remaining := make(map[string]int)
+
+	if limits.MaxConnectionsPerDay > 0 {
+		sent := q.loadSentToday(ctx, connect.StateKeyConnectionState, today, func(data []byte) (string, int, error) {
+			var state connect.ConnectionState
+			if err := json.Unmarshal(data, &state); err != nil {
+				return "", 0, err
+			}
+			return state.Date, state.RequestsSentToday, nil
+		})
+		remaining["connection_request"] = limits.MaxConnectionsPerDay - sent
+	}
+
+	if limits.MaxMessagesPerDay > 0 {
+		sent := q.loadSentToday(ctx, messaging.StateKeyMessageState, today, func(data []byte) (string, int, error) {
+			var state messaging.MessageState
+			if err := json.Unmarshal(data, &state); err != nil {
+				return "", 0, err
+			}
+			return state.Date, state.MessagesSentToday, nil
+		})
+		remaining["message"] = limits.MaxMessagesPerDay - sent
+	}
+
+	return remaining
+}
+
+// loadSentToday loads the state blob under key and, via unmarshal, returns
+// how many were sent on today's date - 0 if the blob is missing, stale
+// (a previous day), or fails to parse, since a missing/stale blob means the
+// daily counter hasn't started yet and the full quota remains.
+func (q *quotaCollector) loadSentToday(ctx context.Context, key, today string, unmarshal func([]byte) (string, int, error)) int {
+	data, err := q.store.Load(ctx, key)
+	if err != nil {
+		if err != storage.ErrNotFound {
+			q.log.Warnw("metrics: failed to load state for quota gauge", "key", key, "error", err)
+		}
+		return 0
+	}
+
+	date, sent, err := unmarshal(data)
+	if err != nil {
+		q.log.Warnw("metrics: failed to parse state for quota gauge", "key", key, "error", err)
+		return 0
+	}
+	if date != today {
+		return 0
+	}
+	return sent
+}
+
+// CheckQuotaIntegrity reports an error if a persisted quota state blob
+// exists but fails to unmarshal. RemainingToday treats that the same as a
+// missing blob (safe for the gauge - it just reports full quota remaining),
+// but a corrupted counter is worth surfacing to callers like app.Status that
+// want to flag it instead of silently masking it.
+func CheckQuotaIntegrity(ctx context.Context, store storage.StateStore) error {
+	checks := []struct {
+		key       string
+		unmarshal func([]byte) error
+	}{
+		{connect.StateKeyConnectionState, func(data []byte) error {
+			var state connect.ConnectionState
+			return json.Unmarshal(data, &state)
+		}},
+		{messaging.StateKeyMessageState, func(data []byte) error {
+			var state messaging.MessageState
+			return json.Unmarshal(data, &state)
+		}},
+	}
+
+	for _, check := range checks {
+		data, err := store.Load(ctx, check.key)
+		if err != nil {
+			if err == storage.ErrNotFound {
+				continue
+			}
+			return fmt.Errorf("load %s: %w", check.key, err)
+		}
+		if err := check.unmarshal(data); err != nil {
+			return fmt.Errorf("parse %s: %w", check.key, err)
+		}
+	}
+
+	return nil
+}
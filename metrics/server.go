@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+// Serve starts an HTTP server exposing c's registry on /metrics at listen
+// (e.g. ":9090"), shutting it down when ctx is done. It runs in the
+// background; call errors are logged, not returned, matching how the rest
+// of main() treats best-effort background work.
+func Serve(ctx context.Context, listen string, c *Collector, log *zap.SugaredLogger) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(c.Registry(), promhttp.HandlerOpts{}))
+
+	srv := &http.Server{Addr: listen, Handler: mux}
+
+	go func() {
+		log.Infow("metrics server listening", "addr", listen)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Errorw("metrics server failed", "error", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Warnw("metrics server shutdown error", "error", err)
+		}
+	}()
+}
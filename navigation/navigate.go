@@ -1,6 +1,7 @@
 package navigation
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -92,35 +93,39 @@ var tabContentSelectors = map[LinkedInTab][]string{
 	},
 }
 
-// NavigateToTab navigates to a specific LinkedIn tab with human-like behavior
-func NavigateToTab(page *rod.Page, tab LinkedInTab, cfg config.TimingConfig, log *zap.SugaredLogger) error {
+// NavigateToTab navigates to a specific LinkedIn tab with human-like behavior.
+// ctx bounds every wait/sleep inside it, so cancelling it stops the tab
+// switch without waiting out its full settle time.
+func NavigateToTab(ctx context.Context, page *rod.Page, tab LinkedInTab, cfg config.TimingConfig, log *zap.SugaredLogger) error {
 	log.Infow("navigating to tab", "tab", string(tab))
 
 	// Check if already on the tab
 	if isOnTab(page, tab) {
 		log.Infow("already on tab", "tab", string(tab))
 		// Still verify content is present
-		if err := waitForTabContent(page, tab, log); err != nil {
+		if err := waitForTabContent(ctx, page, tab, log); err != nil {
 			log.Warnw("content verification failed on current tab", "tab", string(tab), "error", err)
 		}
 		return nil
 	}
 
 	// Try clicking navigation element first (more human-like)
-	if err := clickNavigationTab(page, tab, cfg, log); err != nil {
+	if err := clickNavigationTab(ctx, page, tab, cfg, log); err != nil {
 		log.Warnw("failed to click nav tab, falling back to direct navigation", "tab", string(tab), "error", err)
 		// Fallback to direct URL navigation
-		if err := navigateToTabURL(page, tab, log); err != nil {
+		if err := navigateToTabURL(ctx, page, tab, log); err != nil {
 			return fmt.Errorf("both click and direct navigation failed for %s: %w", tab, err)
 		}
 	}
 
 	// Add extra wait time after navigation to let page fully load
 	log.Infow("waiting for page to settle after navigation", "tab", string(tab))
-	time.Sleep(3 * time.Second)
+	if err := stealth.SleepCtx(ctx, 3*time.Second); err != nil {
+		return err
+	}
 
 	// Wait for content to load - don't fail if this times out
-	if err := waitForTabContent(page, tab, log); err != nil {
+	if err := waitForTabContent(ctx, page, tab, log); err != nil {
 		log.Warnw("content verification timed out, but continuing", "tab", string(tab), "error", err)
 		// Don't return error - navigation may have still succeeded
 	}
@@ -136,15 +141,20 @@ func NavigateToTab(page *rod.Page, tab LinkedInTab, cfg config.TimingConfig, log
 }
 
 // clickNavigationTab finds and clicks the navigation tab element
-func clickNavigationTab(page *rod.Page, tab LinkedInTab, cfg config.TimingConfig, log *zap.SugaredLogger) error {
+func clickNavigationTab(ctx context.Context, page *rod.Page, tab LinkedInTab, cfg config.TimingConfig, log *zap.SugaredLogger) error {
 	selectors, ok := tabSelectors[tab]
 	if !ok {
 		return fmt.Errorf("no selectors defined for tab: %s", tab)
 	}
 
-	// Try each selector with reasonable timeout
+	// Try each selector with reasonable timeout, derived from ctx so an
+	// outer cancellation trims the remaining element lookups too.
 	for _, sel := range selectors {
-		el, err := page.Timeout(10 * time.Second).Element(sel)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		el, err := page.Context(ctx).Timeout(10 * time.Second).Element(sel)
 		if err != nil {
 			log.Debugw("selector not found, trying next", "selector", sel, "error", err)
 			continue
@@ -163,7 +173,10 @@ func clickNavigationTab(page *rod.Page, tab LinkedInTab, cfg config.TimingConfig
 		}
 
 		log.Infow("clicked navigation tab", "tab", string(tab), "selector", sel)
-		time.Sleep(2 * time.Second) // Wait for navigation to start
+		// Wait for navigation to start
+		if err := stealth.SleepCtx(ctx, 2*time.Second); err != nil {
+			return err
+		}
 		return nil
 	}
 
@@ -171,7 +184,7 @@ func clickNavigationTab(page *rod.Page, tab LinkedInTab, cfg config.TimingConfig
 }
 
 // navigateToTabURL directly navigates to the tab URL (fallback method)
-func navigateToTabURL(page *rod.Page, tab LinkedInTab, log *zap.SugaredLogger) error {
+func navigateToTabURL(ctx context.Context, page *rod.Page, tab LinkedInTab, log *zap.SugaredLogger) error {
 	url, ok := tabURLs[tab]
 	if !ok {
 		return fmt.Errorf("no URL defined for tab: %s", tab)
@@ -179,20 +192,20 @@ func navigateToTabURL(page *rod.Page, tab LinkedInTab, log *zap.SugaredLogger) e
 
 	log.Infow("navigating directly to URL", "tab", string(tab), "url", url)
 
+	timedPage := page.Context(ctx).Timeout(30 * time.Second)
+
 	// Navigate with timeout
-	if err := page.Timeout(30 * time.Second).Navigate(url); err != nil {
+	if err := timedPage.Navigate(url); err != nil {
 		return fmt.Errorf("navigate to %s: %w", url, err)
 	}
 
 	// Wait for page load
-	if err := page.Timeout(30 * time.Second).WaitLoad(); err != nil {
+	if err := timedPage.WaitLoad(); err != nil {
 		return fmt.Errorf("wait for page load: %w", err)
 	}
 
 	// Add a small pause after navigation to let page settle
-	time.Sleep(3 * time.Second)
-
-	return nil
+	return stealth.SleepCtx(ctx, 3*time.Second)
 }
 
 // isOnTab checks if currently on the specified tab
@@ -214,7 +227,7 @@ func isOnTab(page *rod.Page, tab LinkedInTab) bool {
 }
 
 // waitForTabContent waits for tab-specific content to appear
-func waitForTabContent(page *rod.Page, tab LinkedInTab, log *zap.SugaredLogger) error {
+func waitForTabContent(ctx context.Context, page *rod.Page, tab LinkedInTab, log *zap.SugaredLogger) error {
 	selectors, ok := tabContentSelectors[tab]
 	if !ok {
 		log.Warnw("no content selectors defined for tab, skipping content verification", "tab", string(tab))
@@ -228,6 +241,9 @@ func waitForTabContent(page *rod.Page, tab LinkedInTab, log *zap.SugaredLogger)
 	defer ticker.Stop()
 
 	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 		if time.Since(startTime) > maxWait {
 			log.Warnw("timeout waiting for content", "tab", string(tab), "timeout", maxWait)
 			return fmt.Errorf("timeout waiting for %s content to load", tab)
@@ -235,22 +251,30 @@ func waitForTabContent(page *rod.Page, tab LinkedInTab, log *zap.SugaredLogger)
 
 		// Try each selector with a short timeout
 		for _, sel := range selectors {
-			if _, err := page.Timeout(2 * time.Second).Element(sel); err == nil {
+			if _, err := page.Context(ctx).Timeout(2 * time.Second).Element(sel); err == nil {
 				log.Infow("tab content loaded", "tab", string(tab), "selector", sel)
 				return nil
 			}
 		}
 
-		<-ticker.C
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
 	}
 }
 
 // NavigateSequence navigates through multiple tabs in sequence with pauses
-func NavigateSequence(page *rod.Page, tabs []LinkedInTab, cfg config.TimingConfig, log *zap.SugaredLogger) error {
+func NavigateSequence(ctx context.Context, page *rod.Page, tabs []LinkedInTab, cfg config.TimingConfig, log *zap.SugaredLogger) error {
 	for i, tab := range tabs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		log.Infow("navigating to tab in sequence", "index", i+1, "total", len(tabs), "tab", string(tab))
 
-		if err := NavigateToTab(page, tab, cfg, log); err != nil {
+		if err := NavigateToTab(ctx, page, tab, cfg, log); err != nil {
 			return fmt.Errorf("failed to navigate to %s: %w", tab, err)
 		}
 
@@ -261,7 +285,9 @@ func NavigateSequence(page *rod.Page, tabs []LinkedInTab, cfg config.TimingConfi
 				max(5000, cfg.MaxDelayMs*2),
 			)
 			log.Infow("pausing before next navigation", "duration", pauseDuration)
-			time.Sleep(pauseDuration)
+			if err := stealth.SleepCtx(ctx, pauseDuration); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -269,9 +295,9 @@ func NavigateSequence(page *rod.Page, tabs []LinkedInTab, cfg config.TimingConfi
 }
 
 // ScrollCurrentTab scrolls the current page/tab
-func ScrollCurrentTab(page *rod.Page, duration time.Duration, cfg config.TimingConfig, log *zap.SugaredLogger) error {
+func ScrollCurrentTab(ctx context.Context, page *rod.Page, duration time.Duration, cfg config.TimingConfig, log *zap.SugaredLogger) error {
 	log.Infow("scrolling current tab", "duration", duration)
-	return stealth.ScrollFeedHuman(page, cfg, duration)
+	return stealth.ScrollFeedHuman(ctx, page, cfg, duration)
 }
 
 func max(a, b int) int {
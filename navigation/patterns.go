@@ -1,6 +1,7 @@
 package navigation
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -8,6 +9,8 @@ import (
 	"go.uber.org/zap"
 
 	"linkedin-automation-tool/config"
+	"linkedin-automation-tool/metrics"
+	"linkedin-automation-tool/stealth"
 )
 
 // NavigationPattern represents a predefined navigation workflow
@@ -22,7 +25,7 @@ type TabWithAction struct {
 	Tab          LinkedInTab
 	ScrollTime   time.Duration // How long to scroll (0 = no scroll)
 	PauseAfter   time.Duration // Pause after this tab (0 = use default)
-	CustomAction func(*rod.Page, config.TimingConfig, *zap.SugaredLogger) error
+	CustomAction func(context.Context, *rod.Page, config.TimingConfig, *zap.SugaredLogger) error
 }
 
 // Predefined navigation patterns
@@ -80,20 +83,32 @@ var (
 	}
 )
 
-// ExecutePattern executes a predefined navigation pattern
-func ExecutePattern(page *rod.Page, pattern NavigationPattern, cfg config.TimingConfig, log *zap.SugaredLogger) error {
+// ExecutePattern executes a predefined navigation pattern. metricsCollector
+// may be nil; every Collector method no-ops in that case. ctx is checked
+// before each step and threaded into navigation/scroll, so cancelling it
+// (e.g. via Agent.Pause or Ctrl-C) stops the pattern between tabs rather than
+// only after it finishes.
+func ExecutePattern(ctx context.Context, page *rod.Page, pattern NavigationPattern, cfg config.TimingConfig, metricsCollector *metrics.Collector, log *zap.SugaredLogger) error {
 	log.Infow("executing navigation pattern", "pattern", pattern.Name, "description", pattern.Description)
 
 	successCount := 0
 	failCount := 0
 
 	for i, tabAction := range pattern.Tabs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		log.Infow("executing tab action", "step", i+1, "total", len(pattern.Tabs), "tab", string(tabAction.Tab))
 
 		// Navigate to tab
 		log.Infow("attempting navigation", "tab", string(tabAction.Tab))
-		if err := NavigateToTab(page, tabAction.Tab, cfg, log); err != nil {
+		if err := NavigateToTab(ctx, page, tabAction.Tab, cfg, log); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
 			log.Warnw("navigation failed, continuing with next tab", "tab", string(tabAction.Tab), "error", err)
+			metricsCollector.ObserveNavigationFailure(string(tabAction.Tab))
 			failCount++
 			// Continue to next tab instead of failing entirely
 			continue
@@ -104,7 +119,7 @@ func ExecutePattern(page *rod.Page, pattern NavigationPattern, cfg config.Timing
 		// Execute custom action if provided
 		if tabAction.CustomAction != nil {
 			log.Infow("executing custom action", "tab", string(tabAction.Tab))
-			if err := tabAction.CustomAction(page, cfg, log); err != nil {
+			if err := tabAction.CustomAction(ctx, page, cfg, log); err != nil {
 				log.Warnw("custom action failed", "tab", string(tabAction.Tab), "error", err)
 			}
 		}
@@ -112,9 +127,14 @@ func ExecutePattern(page *rod.Page, pattern NavigationPattern, cfg config.Timing
 		// Scroll if specified
 		if tabAction.ScrollTime > 0 {
 			log.Infow("scrolling on tab", "tab", string(tabAction.Tab), "duration", tabAction.ScrollTime)
-			if err := ScrollCurrentTab(page, tabAction.ScrollTime, cfg, log); err != nil {
+			scrollStart := time.Now()
+			if err := ScrollCurrentTab(ctx, page, tabAction.ScrollTime, cfg, log); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
 				log.Warnw("scroll failed", "tab", string(tabAction.Tab), "error", err)
 			} else {
+				metricsCollector.ObserveTabScrollDuration(string(tabAction.Tab), time.Since(scrollStart))
 				log.Infow("scroll completed", "tab", string(tabAction.Tab))
 			}
 		}
@@ -130,7 +150,9 @@ func ExecutePattern(page *rod.Page, pattern NavigationPattern, cfg config.Timing
 				)
 			}
 			log.Infow("pausing before next action", "duration", pauseDuration, "nextTab", string(pattern.Tabs[i+1].Tab))
-			time.Sleep(pauseDuration)
+			if err := stealth.SleepCtx(ctx, pauseDuration); err != nil {
+				return err
+			}
 			log.Infow("pause completed, moving to next tab")
 		}
 	}
@@ -162,13 +184,13 @@ func RandomDelay(minMs, maxMs int) time.Duration {
 }
 
 // NavigateAndInteract is a helper for simple tab navigation with interaction
-func NavigateAndInteract(page *rod.Page, tab LinkedInTab, scrollDuration time.Duration, cfg config.TimingConfig, log *zap.SugaredLogger) error {
-	if err := NavigateToTab(page, tab, cfg, log); err != nil {
+func NavigateAndInteract(ctx context.Context, page *rod.Page, tab LinkedInTab, scrollDuration time.Duration, cfg config.TimingConfig, log *zap.SugaredLogger) error {
+	if err := NavigateToTab(ctx, page, tab, cfg, log); err != nil {
 		return err
 	}
 
 	if scrollDuration > 0 {
-		return ScrollCurrentTab(page, scrollDuration, cfg, log)
+		return ScrollCurrentTab(ctx, page, scrollDuration, cfg, log)
 	}
 
 	return nil
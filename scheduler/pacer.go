@@ -0,0 +1,306 @@
+// Package scheduler paces a set of actions (connect, message, view profile,
+// ...) over time instead of running them back-to-back: an average interval
+// plus jitter spaces individual actions out, per-hour weights cluster
+// activity around plausible working hours, and a persisted daily budget
+// hard-stops an action type once it's spent for the day. It's a general
+// pacing primitive the connection/messaging loops can sit behind; it
+// doesn't know anything about LinkedIn or the Agent's cron schedules
+// (see agent.Agent.Schedules for that).
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/stealth"
+	"linkedin-automation-tool/storage"
+)
+
+// Action is one unit of human-behavior activity the Pacer can choose
+// between. Weight controls how often it's picked relative to the other
+// actions (weighted random choice); MinCooldown is a hard floor on how soon
+// the same action can run again, independent of the random interval.
+type Action struct {
+	Name        string
+	Weight      float64
+	MinCooldown time.Duration
+	Run         func(ctx context.Context) error
+}
+
+// Budgets caps how many times each Action (keyed by Name) may run per day.
+// An action absent from Budgets, or mapped to 0, is unlimited.
+type Budgets map[string]int
+
+// Config tunes a Pacer's pacing. The average time between actions is
+// Interval, randomized by +/- up to Jitter (Jitter must be < Interval).
+// HourlyWeights, if set, must have exactly 24 entries (index = hour of day,
+// 0-23) summing to more than zero; hours with an above-average weight get a
+// shorter effective interval, so activity clusters around plausible working
+// hours instead of firing at a flat rate around the clock. RandSeed, when
+// non-zero, makes the weighted choice and jitter reproducible (e.g. for a
+// --rand-seed flag); zero seeds from the current time.
+type Config struct {
+	Interval      time.Duration
+	Jitter        time.Duration
+	HourlyWeights []float64
+	Budgets       Budgets
+	RandSeed      int64
+}
+
+func (c Config) validate() error {
+	if c.Interval <= 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+	if c.Jitter < 0 {
+		return fmt.Errorf("jitter must not be negative")
+	}
+	if c.Jitter >= c.Interval {
+		return fmt.Errorf("jitter (%s) must be less than interval (%s)", c.Jitter, c.Interval)
+	}
+	if c.HourlyWeights != nil {
+		if len(c.HourlyWeights) != 24 {
+			return fmt.Errorf("hourly weights must have exactly 24 entries, got %d", len(c.HourlyWeights))
+		}
+		var sum float64
+		for _, w := range c.HourlyWeights {
+			sum += w
+		}
+		if sum <= 0 {
+			return fmt.Errorf("hourly weights must sum to more than zero")
+		}
+	}
+	return nil
+}
+
+// budgetState is the on-disk counter Pacer persists so a restart doesn't
+// reset the day's budget.
+type budgetState struct {
+	Date   string         `json:"date"`
+	Counts map[string]int `json:"counts"`
+}
+
+func newBudgetState() *budgetState {
+	return &budgetState{
+		Date:   time.Now().Format("2006-01-02"),
+		Counts: make(map[string]int),
+	}
+}
+
+// budgetStateKeyPrefix namespaces Pacer's counter file from every other key
+// a StateStore holds (session cookies, message/connection state, ...).
+const budgetStateKeyPrefix = "scheduler_budget_"
+
+// Pacer runs a weighted-random rotation of Actions, spaced by Config's
+// interval and jitter. It stops offering an action once its daily budget is
+// spent, and stops entirely once every action with a positive Weight is out
+// of budget; while the rest are only inside their MinCooldown it keeps
+// waiting.
+type Pacer struct {
+	cfg     Config
+	actions []Action
+	store   storage.StateStore
+	log     *zap.SugaredLogger
+	rng     *rand.Rand
+
+	stateKey string
+	state    *budgetState
+	lastRun  map[string]time.Time
+}
+
+// New builds a Pacer. id namespaces the persisted budget counter, so
+// multiple Pacers (e.g. one per LinkedIn account) sharing a StateStore
+// don't clobber each other's counts.
+func New(id string, cfg Config, actions []Action, store storage.StateStore, log *zap.SugaredLogger) (*Pacer, error) {
+	if len(actions) == 0 {
+		return nil, fmt.Errorf("at least one action is required")
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid pacer config: %w", err)
+	}
+	for _, a := range actions {
+		if a.Name == "" {
+			return nil, fmt.Errorf("action name must not be empty")
+		}
+		if a.Run == nil {
+			return nil, fmt.Errorf("action %q: Run must not be nil", a.Name)
+		}
+	}
+
+	seed := cfg.RandSeed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	return &Pacer{
+		cfg:      cfg,
+		actions:  actions,
+		store:    store,
+		log:      log,
+		rng:      rand.New(rand.NewSource(seed)),
+		stateKey: budgetStateKeyPrefix + id,
+		lastRun:  make(map[string]time.Time),
+	}, nil
+}
+
+// Run drives the pacing loop until ctx is cancelled or every action is
+// permanently unavailable (today's budget spent, with nothing left to wait
+// out until the next day). It blocks.
+func (p *Pacer) Run(ctx context.Context) error {
+	state, err := p.loadBudget(ctx)
+	if err != nil {
+		p.log.Warnw("failed to load pacer budget, starting fresh", "error", err)
+		state = newBudgetState()
+	}
+	p.state = state
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		now := time.Now()
+		today := now.Format("2006-01-02")
+		if p.state.Date != today {
+			p.log.Infow("new day detected, resetting pacer budget", "previousDate", p.state.Date, "today", today)
+			p.state.Date = today
+			p.state.Counts = make(map[string]int)
+		}
+
+		action, ok := p.pickAction(now)
+		if !ok {
+			inBudget := false
+			for _, a := range p.actions {
+				if limit := p.cfg.Budgets[a.Name]; a.Weight > 0 && (limit <= 0 || p.state.Counts[a.Name] < limit) {
+					inBudget = true
+					break
+				}
+			}
+			if !inBudget {
+				p.log.Info("every action is out of budget for today, stopping pacer")
+				return nil
+			}
+			// Everything still in budget is inside its MinCooldown; wait it out.
+			if err := stealth.SleepCtx(ctx, p.nextInterval(time.Now())); err != nil {
+				return err
+			}
+			continue
+		}
+
+		p.log.Infow("pacer running action", "action", action.Name)
+		if err := action.Run(ctx); err != nil {
+			p.log.Warnw("pacer action failed", "action", action.Name, "error", err)
+		} else {
+			p.state.Counts[action.Name]++
+			p.lastRun[action.Name] = now
+		}
+
+		if err := p.saveBudget(ctx); err != nil {
+			p.log.Warnw("failed to save pacer budget", "error", err)
+		}
+
+		wait := p.nextInterval(time.Now())
+		p.log.Infow("pacer sleeping until next action", "duration", wait)
+		if err := stealth.SleepCtx(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// pickAction does a weighted random choice among actions that are neither
+// over budget nor still in their MinCooldown. ok is false once nothing
+// qualifies.
+func (p *Pacer) pickAction(now time.Time) (Action, bool) {
+	var eligible []Action
+	var totalWeight float64
+
+	for _, a := range p.actions {
+		if a.Weight <= 0 {
+			continue
+		}
+		if limit, capped := p.cfg.Budgets[a.Name]; capped && limit > 0 && p.state.Counts[a.Name] >= limit {
+			continue
+		}
+		if last, ran := p.lastRun[a.Name]; ran && now.Sub(last) < a.MinCooldown {
+			continue
+		}
+		eligible = append(eligible, a)
+		totalWeight += a.Weight
+	}
+
+	if len(eligible) == 0 {
+		return Action{}, false
+	}
+
+	r := p.rng.Float64() * totalWeight
+	for _, a := range eligible {
+		r -= a.Weight
+		if r <= 0 {
+			return a, true
+		}
+	}
+
+	return eligible[len(eligible)-1], true
+}
+
+// nextInterval applies the current hour's weight (if configured) to the
+// base interval, then adds up to +/- Jitter.
+func (p *Pacer) nextInterval(now time.Time) time.Duration {
+	interval := p.cfg.Interval
+
+	if len(p.cfg.HourlyWeights) == 24 {
+		var sum float64
+		for _, w := range p.cfg.HourlyWeights {
+			sum += w
+		}
+		avg := sum / 24
+		factor := p.cfg.HourlyWeights[now.Hour()] / avg
+		if factor > 0 {
+			interval = time.Duration(float64(interval) / factor)
+		}
+	}
+
+	if p.cfg.Jitter > 0 {
+		delta := time.Duration((p.rng.Float64()*2 - 1) * float64(p.cfg.Jitter))
+		interval += delta
+	}
+
+	if interval < time.Second {
+		interval = time.Second
+	}
+
+	return interval
+}
+
+func (p *Pacer) loadBudget(ctx context.Context) (*budgetState, error) {
+	data, err := p.store.Load(ctx, p.stateKey)
+	if err != nil {
+		return nil, fmt.Errorf("load budget state: %w", err)
+	}
+
+	if len(data) == 0 {
+		return newBudgetState(), nil
+	}
+
+	var state budgetState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("unmarshal budget state: %w", err)
+	}
+	if state.Counts == nil {
+		state.Counts = make(map[string]int)
+	}
+
+	return &state, nil
+}
+
+func (p *Pacer) saveBudget(ctx context.Context) error {
+	data, err := json.Marshal(p.state)
+	if err != nil {
+		return fmt.Errorf("marshal budget state: %w", err)
+	}
+	return p.store.Save(ctx, p.stateKey, data)
+}
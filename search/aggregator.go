@@ -0,0 +1,172 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultSourceTimeout    = 20 * time.Second
+	circuitBreakerThreshold = 3
+	circuitBreakerCooldown  = 5 * time.Minute
+)
+
+// circuitBreaker trips a Source out of rotation after a run of consecutive
+// failures, so one flaky backend doesn't cost every aggregated search its
+// full per-source timeout. A failure is a Search call that returns an error,
+// or a source whose context ended (timeout or cancellation) before it closed
+// its channel; errors a source hits after Search has returned (e.g. an HTTP
+// error inside CSESource) are not counted.
+type circuitBreaker struct {
+	mu               sync.Mutex
+	consecutiveFails int
+	openUntil        time.Time
+}
+
+func (b *circuitBreaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return now.After(b.openUntil)
+}
+
+func (b *circuitBreaker) recordResult(err error, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err == nil {
+		b.consecutiveFails = 0
+		return
+	}
+	b.consecutiveFails++
+	if b.consecutiveFails >= circuitBreakerThreshold {
+		b.openUntil = now.Add(circuitBreakerCooldown)
+	}
+}
+
+// Aggregator is a metasearch-style fan-in collector over multiple Sources:
+// it dispatches a SearchParams query to every source in parallel, merges and
+// dedupes results by normalizeProfileURL, and ranks them by a weighted score
+// combining source priority with how many independent sources agreed on a
+// profile. Circuit breaker state persists across calls, so reuse one
+// Aggregator for a process's lifetime rather than building a fresh one per
+// search.
+type Aggregator struct {
+	sources []Source
+	weights map[string]float64
+	timeout time.Duration
+	log     *zap.SugaredLogger
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+// NewAggregator builds an Aggregator over sources. weights maps a Source's
+// Name() to its priority (higher wins ties); a source with no entry
+// defaults to 1.0. timeout bounds how long any single source gets per
+// search before the aggregator moves on without it (defaults to 20s).
+func NewAggregator(sources []Source, weights map[string]float64, timeout time.Duration, log *zap.SugaredLogger) *Aggregator {
+	if timeout <= 0 {
+		timeout = defaultSourceTimeout
+	}
+	return &Aggregator{
+		sources:  sources,
+		weights:  weights,
+		timeout:  timeout,
+		log:      log,
+		breakers: make(map[string]*circuitBreaker),
+	}
+}
+
+// FindPeopleMulti dispatches params to every configured Source in parallel
+// and returns the merged, deduped, ranked result set - highest score first.
+func (a *Aggregator) FindPeopleMulti(ctx context.Context, params SearchParams) ([]SearchResult, error) {
+	type tally struct {
+		result SearchResult
+		score  float64
+		seenBy map[string]bool
+	}
+
+	merged := make(map[string]*tally)
+	var mergedMu sync.Mutex
+
+	var wg sync.WaitGroup
+	now := time.Now()
+
+	for _, src := range a.sources {
+		src := src
+		breaker := a.breakerFor(src.Name())
+		if !breaker.allow(now) {
+			a.log.Warnw("skipping source, circuit open", "source", src.Name())
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			srcCtx, cancel := context.WithTimeout(ctx, a.timeout)
+			defer cancel()
+
+			results, err := src.Search(srcCtx, params)
+			if err != nil {
+				a.log.Warnw("source search failed to start", "source", src.Name(), "error", err)
+				breaker.recordResult(err, time.Now())
+				return
+			}
+
+			for r := range results {
+				normalized := normalizeProfileURL(r.ProfileURL)
+				if normalized == "" {
+					continue
+				}
+				r.ProfileURL = normalized
+				r.Source = src.Name()
+
+				mergedMu.Lock()
+				t, ok := merged[normalized]
+				if !ok {
+					t = &tally{result: r, seenBy: make(map[string]bool)}
+					merged[normalized] = t
+				}
+				if !t.seenBy[src.Name()] {
+					t.seenBy[src.Name()] = true
+					t.score += a.weightFor(src.Name())
+				}
+				mergedMu.Unlock()
+			}
+
+			breaker.recordResult(srcCtx.Err(), time.Now())
+		}()
+	}
+	wg.Wait()
+
+	out := make([]SearchResult, 0, len(merged))
+	for _, t := range merged {
+		t.result.Score = t.score
+		out = append(out, t.result)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Score > out[j].Score })
+
+	return out, nil
+}
+
+func (a *Aggregator) weightFor(name string) float64 {
+	if w, ok := a.weights[name]; ok {
+		return w
+	}
+	return 1.0
+}
+
+func (a *Aggregator) breakerFor(name string) *circuitBreaker {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	b, ok := a.breakers[name]
+	if !ok {
+		b = &circuitBreaker{}
+		a.breakers[name] = b
+	}
+	return b
+}
@@ -31,6 +31,14 @@ type SearchResult struct {
 	ProfileURL string
 	Name       string // Optional: profile name if extracted
 	Headline   string // Optional: headline if extracted
+
+	// Source identifies which Source produced this result; set by the
+	// Aggregator, empty for results from FindPeople directly.
+	Source string
+	// Score is the Aggregator's weighted rank: source priority summed
+	// across every source that independently surfaced this profile. Zero
+	// for results from FindPeople directly.
+	Score float64
 }
 
 const (
@@ -67,7 +75,10 @@ func FindPeople(
 
 	// Use human-like search typing instead of URL navigation
 	log.Info("performing human-like search via search box...")
-	if err := performHumanSearch(page, params, cfg, log); err != nil {
+	if err := performHumanSearch(ctx, page, params, cfg, log); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		log.Warnw("human search failed, falling back to URL navigation", "error", err)
 		// Fallback to URL navigation
 		searchURL, err := buildSearchURL(params)
@@ -75,20 +86,23 @@ func FindPeople(
 			return nil, fmt.Errorf("build search URL: %w", err)
 		}
 		log.Infow("navigating to search", "url", searchURL)
-		if err := page.Timeout(30 * time.Second).Navigate(searchURL); err != nil {
+		timedPage := page.Context(ctx).Timeout(30 * time.Second)
+		if err := timedPage.Navigate(searchURL); err != nil {
 			return nil, fmt.Errorf("navigate to search: %w", err)
 		}
-		if err := page.Timeout(30 * time.Second).WaitLoad(); err != nil {
+		if err := timedPage.WaitLoad(); err != nil {
 			return nil, fmt.Errorf("wait for search page load: %w", err)
 		}
 	}
 
 	// Wait for search results to appear
-	time.Sleep(3 * time.Second)
+	if err := stealth.SleepCtx(ctx, 3*time.Second); err != nil {
+		return nil, err
+	}
 
 	// Human-like: scroll to trigger lazy-loaded content
 	log.Info("scrolling to load results")
-	if err := humanScrollOnce(page, cfg); err != nil {
+	if err := humanScrollOnce(ctx, page, cfg); err != nil {
 		log.Warnw("initial scroll failed", "error", err)
 	}
 
@@ -101,10 +115,14 @@ func FindPeople(
 	}
 
 	for currentPage <= maxPages {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Infow("processing search results page", "page", currentPage, "maxPages", maxPages)
 
 		// Extract profile URLs from current page
-		profiles, err := extractProfileURLs(page, log)
+		profiles, err := extractProfileURLs(ctx, page, log)
 		if err != nil {
 			return nil, fmt.Errorf("extract profiles on page %d: %w", currentPage, err)
 		}
@@ -133,7 +151,10 @@ func FindPeople(
 		log.Infow("new profiles on page", "page", currentPage, "new", newCount, "duplicates", len(profiles)-newCount)
 
 		// Human-like: scroll through results
-		if err := humanScrollResults(page, cfg, log); err != nil {
+		if err := humanScrollResults(ctx, page, cfg, log); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
 			log.Warnw("scroll results failed", "error", err)
 		}
 
@@ -151,16 +172,21 @@ func FindPeople(
 
 		// Click next page with human-like behavior
 		log.Infow("navigating to next page", "nextPage", currentPage+1)
-		if err := clickNextPage(page, cfg, log); err != nil {
+		if err := clickNextPage(ctx, page, cfg, log); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
 			log.Warnw("failed to navigate to next page", "page", currentPage, "error", err)
 			break
 		}
 
 		// Wait for new results to load
-		time.Sleep(3 * time.Second)
+		if err := stealth.SleepCtx(ctx, 3*time.Second); err != nil {
+			return nil, err
+		}
 
 		// Human-like: scroll to trigger new content
-		if err := humanScrollOnce(page, cfg); err != nil {
+		if err := humanScrollOnce(ctx, page, cfg); err != nil {
 			log.Warnw("scroll after pagination failed", "error", err)
 		}
 
@@ -172,7 +198,9 @@ func FindPeople(
 			max(5000, cfg.MaxDelayMs*3),
 		)
 		log.Debugw("pausing before next page", "duration", pauseDuration)
-		time.Sleep(pauseDuration)
+		if err := stealth.SleepCtx(ctx, pauseDuration); err != nil {
+			return nil, err
+		}
 	}
 
 	// Save updated seen profiles to state
@@ -228,19 +256,25 @@ func buildSearchURL(params SearchParams) (string, error) {
 }
 
 // extractProfileURLs extracts all profile URLs from the current search results page
-func extractProfileURLs(page *rod.Page, log *zap.SugaredLogger) ([]string, error) {
+func extractProfileURLs(ctx context.Context, page *rod.Page, log *zap.SugaredLogger) ([]string, error) {
 	// Wait longer for dynamic content to load
 	log.Info("waiting for search result cards to load...")
-	time.Sleep(3 * time.Second)
+	if err := stealth.SleepCtx(ctx, 3*time.Second); err != nil {
+		return nil, err
+	}
 
 	// Scroll down to trigger lazy-loaded content
 	for i := 0; i < 3; i++ {
 		_ = page.Mouse.Scroll(0, 300, 1)
-		time.Sleep(800 * time.Millisecond)
+		if err := stealth.SleepCtx(ctx, 800*time.Millisecond); err != nil {
+			return nil, err
+		}
 	}
 
 	// Additional wait after scrolling
-	time.Sleep(2 * time.Second)
+	if err := stealth.SleepCtx(ctx, 2*time.Second); err != nil {
+		return nil, err
+	}
 
 	// LinkedIn search results use various selectors over time
 	// We try multiple strategies for robustness
@@ -415,7 +449,7 @@ func hasNextPage(page *rod.Page) (bool, error) {
 }
 
 // clickNextPage clicks the next page button with human-like behavior
-func clickNextPage(page *rod.Page, cfg config.TimingConfig, log *zap.SugaredLogger) error {
+func clickNextPage(ctx context.Context, page *rod.Page, cfg config.TimingConfig, log *zap.SugaredLogger) error {
 	nextButtonSelectors := []string{
 		"button[aria-label='Next']",
 		"button.artdeco-pagination__button--next",
@@ -423,7 +457,7 @@ func clickNextPage(page *rod.Page, cfg config.TimingConfig, log *zap.SugaredLogg
 	}
 
 	for _, sel := range nextButtonSelectors {
-		el, err := page.Timeout(5 * time.Second).Element(sel)
+		el, err := page.Context(ctx).Timeout(5 * time.Second).Element(sel)
 		if err != nil {
 			continue
 		}
@@ -433,7 +467,9 @@ func clickNextPage(page *rod.Page, cfg config.TimingConfig, log *zap.SugaredLogg
 			log.Warnw("scroll to next button failed", "error", err)
 		}
 
-		time.Sleep(stealth.RandomDelay(500, 1000))
+		if err := stealth.SleepCtx(ctx, stealth.RandomDelay(500, 1000)); err != nil {
+			return err
+		}
 
 		// Click the button
 		if err := el.Click("left", 1); err != nil {
@@ -444,29 +480,31 @@ func clickNextPage(page *rod.Page, cfg config.TimingConfig, log *zap.SugaredLogg
 		log.Infow("clicked next page button", "selector", sel)
 
 		// Wait for navigation to start
-		time.Sleep(2 * time.Second)
-		return nil
+		return stealth.SleepCtx(ctx, 2*time.Second)
 	}
 
 	return fmt.Errorf("could not find or click next page button")
 }
 
 // humanScrollOnce performs a single human-like scroll
-func humanScrollOnce(page *rod.Page, cfg config.TimingConfig) error {
+func humanScrollOnce(ctx context.Context, page *rod.Page, cfg config.TimingConfig) error {
 	scrollDistance := 300 + (time.Now().UnixNano() % 400) // 300-700px
-	if err := page.Timeout(5 * time.Second).Mouse.Scroll(0, float64(scrollDistance), 1); err != nil {
+	if err := page.Context(ctx).Timeout(5 * time.Second).Mouse.Scroll(0, float64(scrollDistance), 1); err != nil {
 		return err
 	}
-	time.Sleep(stealth.RandomDelay(800, 1500))
-	return nil
+	return stealth.SleepCtx(ctx, stealth.RandomDelay(800, 1500))
 }
 
 // humanScrollResults scrolls through the search results page in a human-like way
-func humanScrollResults(page *rod.Page, cfg config.TimingConfig, log *zap.SugaredLogger) error {
+func humanScrollResults(ctx context.Context, page *rod.Page, cfg config.TimingConfig, log *zap.SugaredLogger) error {
 	// Scroll down a few times to view results
 	scrolls := 2 + (int(time.Now().UnixNano()) % 3) // 2-4 scrolls
 
 	for i := 0; i < scrolls; i++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		scrollDistance := 400 + (time.Now().UnixNano() % 400) // 400-800px
 
 		if err := page.Timeout(5 * time.Second).Mouse.Scroll(0, float64(scrollDistance), 1); err != nil {
@@ -475,16 +513,20 @@ func humanScrollResults(page *rod.Page, cfg config.TimingConfig, log *zap.Sugare
 		}
 
 		// Pause between scrolls
-		time.Sleep(stealth.RandomDelay(
+		if err := stealth.SleepCtx(ctx, stealth.RandomDelay(
 			max(1000, cfg.MinDelayMs*2),
 			max(3000, cfg.MaxDelayMs*2),
-		))
+		)); err != nil {
+			return err
+		}
 
 		// Occasionally scroll back up slightly (human behavior)
 		if i > 0 && time.Now().UnixNano()%4 == 0 {
 			backScroll := 100 + (time.Now().UnixNano() % 200)
 			_ = page.Timeout(5 * time.Second).Mouse.Scroll(0, -float64(backScroll), 1)
-			time.Sleep(stealth.RandomDelay(500, 1000))
+			if err := stealth.SleepCtx(ctx, stealth.RandomDelay(500, 1000)); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -557,7 +599,7 @@ func max(a, b int) int {
 }
 
 // performHumanSearch performs a search using the search box with human-like typing
-func performHumanSearch(page *rod.Page, params SearchParams, cfg config.TimingConfig, log *zap.SugaredLogger) error {
+func performHumanSearch(ctx context.Context, page *rod.Page, params SearchParams, cfg config.TimingConfig, log *zap.SugaredLogger) error {
 	// Build search query
 	var queryParts []string
 	if params.Keywords != "" {
@@ -584,13 +626,16 @@ func performHumanSearch(page *rod.Page, params SearchParams, cfg config.TimingCo
 	currentURL := page.MustInfo().URL
 	if !strings.Contains(currentURL, "linkedin.com/feed") && !strings.Contains(currentURL, "linkedin.com/search") {
 		log.Info("navigating to LinkedIn feed first...")
-		if err := page.Timeout(30 * time.Second).Navigate(linkedInHomeURL); err != nil {
+		timedPage := page.Context(ctx).Timeout(30 * time.Second)
+		if err := timedPage.Navigate(linkedInHomeURL); err != nil {
 			return fmt.Errorf("navigate to feed: %w", err)
 		}
-		if err := page.Timeout(30 * time.Second).WaitLoad(); err != nil {
+		if err := timedPage.WaitLoad(); err != nil {
 			return fmt.Errorf("wait for feed: %w", err)
 		}
-		time.Sleep(2 * time.Second)
+		if err := stealth.SleepCtx(ctx, 2*time.Second); err != nil {
+			return err
+		}
 	}
 
 	// Find and click the search box
@@ -623,7 +668,9 @@ func performHumanSearch(page *rod.Page, params SearchParams, cfg config.TimingCo
 	if err := searchBox.ScrollIntoView(); err != nil {
 		log.Warnw("scroll to search box failed", "error", err)
 	}
-	time.Sleep(stealth.RandomDelay(300, 700))
+	if err := stealth.SleepCtx(ctx, stealth.RandomDelay(300, 700)); err != nil {
+		return err
+	}
 
 	// Click search box
 	log.Info("clicking search box...")
@@ -631,16 +678,20 @@ func performHumanSearch(page *rod.Page, params SearchParams, cfg config.TimingCo
 		return fmt.Errorf("click search box: %w", err)
 	}
 
-	time.Sleep(stealth.RandomDelay(500, 1000))
+	if err := stealth.SleepCtx(ctx, stealth.RandomDelay(500, 1000)); err != nil {
+		return err
+	}
 
 	// Type search query with human-like behavior
 	log.Infow("typing search query", "query", searchQuery)
-	if err := stealth.TypeHuman(searchBox, searchQuery, cfg); err != nil {
+	if err := stealth.TypeHuman(ctx, searchBox, searchQuery, cfg); err != nil {
 		return fmt.Errorf("type search query: %w", err)
 	}
 
 	// Wait a bit before submitting (human reads what they typed)
-	time.Sleep(stealth.RandomDelay(800, 1500))
+	if err := stealth.SleepCtx(ctx, stealth.RandomDelay(800, 1500)); err != nil {
+		return err
+	}
 
 	// Submit search - try Enter key first
 	log.Info("submitting search with Enter key...")
@@ -672,7 +723,9 @@ func performHumanSearch(page *rod.Page, params SearchParams, cfg config.TimingCo
 
 	// Wait for navigation to search results
 	log.Info("waiting for search results to load...")
-	time.Sleep(3 * time.Second)
+	if err := stealth.SleepCtx(ctx, 3*time.Second); err != nil {
+		return err
+	}
 
 	// Wait for URL to change to search results
 	startTime := time.Now()
@@ -682,7 +735,9 @@ func performHumanSearch(page *rod.Page, params SearchParams, cfg config.TimingCo
 			log.Infow("search results loaded", "url", currentURL)
 			break
 		}
-		time.Sleep(500 * time.Millisecond)
+		if err := stealth.SleepCtx(ctx, 500*time.Millisecond); err != nil {
+			return err
+		}
 	}
 
 	// Check if we need to click "People" filter
@@ -703,7 +758,9 @@ func performHumanSearch(page *rod.Page, params SearchParams, cfg config.TimingCo
 			if err == nil {
 				if err := filterBtn.Click("left", 1); err == nil {
 					log.Info("clicked People filter")
-					time.Sleep(3 * time.Second) // Wait longer for filter to apply
+					if err := stealth.SleepCtx(ctx, 3*time.Second); err != nil { // Wait longer for filter to apply
+						return err
+					}
 					clickedFilter = true
 					break
 				}
@@ -716,13 +773,17 @@ func performHumanSearch(page *rod.Page, params SearchParams, cfg config.TimingCo
 	}
 
 	// Final wait for results to load with additional scrolling
-	time.Sleep(2 * time.Second)
-	
+	if err := stealth.SleepCtx(ctx, 2*time.Second); err != nil {
+		return err
+	}
+
 	// Scroll to trigger lazy-loaded profile cards
 	log.Info("scrolling to load more profile cards...")
 	for i := 0; i < 2; i++ {
 		_ = page.Mouse.Scroll(0, 400, 1)
-		time.Sleep(time.Second)
+		if err := stealth.SleepCtx(ctx, time.Second); err != nil {
+			return err
+		}
 	}
 
 	log.Info("search submitted successfully via human-like typing")
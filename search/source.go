@@ -0,0 +1,18 @@
+package search
+
+import "context"
+
+// Source is one backend the Aggregator can query in parallel: LinkedIn's
+// own people search, Sales Navigator, a general web search engine indexing
+// "site:linkedin.com/in/" pages, or a local cache of previously-seen
+// profiles. Search streams results as they're found rather than buffering
+// them, so a slow source doesn't hold up faster ones reporting back.
+type Source interface {
+	// Name identifies the source in logs and in SearchResult.Source.
+	Name() string
+	// Search dispatches params against this source. The returned channel is
+	// closed when the source is done (or ctx is cancelled). A non-nil error
+	// means the source couldn't even start (e.g. missing API key) - the
+	// channel is nil in that case.
+	Search(ctx context.Context, params SearchParams) (<-chan SearchResult, error)
+}
@@ -0,0 +1,65 @@
+package search
+
+import (
+	"context"
+
+	"github.com/go-rod/rod"
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/config"
+	"linkedin-automation-tool/storage"
+)
+
+// LinkedInSource is a Source backed by FindPeople's Rod-driven scrape of
+// LinkedIn's own people-search UI - the aggregator's highest-fidelity but
+// also most rate-limit-exposed source.
+type LinkedInSource struct {
+	Page  *rod.Page
+	Store storage.StateStore
+	Cfg   config.TimingConfig
+	Log   *zap.SugaredLogger
+}
+
+func (s *LinkedInSource) Name() string { return "linkedin" }
+
+func (s *LinkedInSource) Search(ctx context.Context, params SearchParams) (<-chan SearchResult, error) {
+	out := make(chan SearchResult)
+	go func() {
+		defer close(out)
+		profiles, err := FindPeople(ctx, s.Page, s.Store, params, s.Cfg, s.Log)
+		if err != nil {
+			s.Log.Warnw("linkedin source search failed", "error", err)
+			return
+		}
+		for _, p := range profiles {
+			select {
+			case out <- SearchResult{ProfileURL: p}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// SalesNavigatorSource targets LinkedIn Sales Navigator's people-search UI
+// instead of the standard search/results/people/ flow used by FindPeople.
+// Sales Navigator's DOM and URL scheme differ enough from regular search
+// (a separate gated product, different result card markup) that it needs
+// its own extraction selectors rather than reusing extractProfileURLs
+// against a page they were never written for. Until those selectors are
+// mapped out, Search honestly reports no results instead of guessing.
+type SalesNavigatorSource struct {
+	Page *rod.Page
+	Cfg  config.TimingConfig
+	Log  *zap.SugaredLogger
+}
+
+func (s *SalesNavigatorSource) Name() string { return "sales_navigator" }
+
+func (s *SalesNavigatorSource) Search(ctx context.Context, params SearchParams) (<-chan SearchResult, error) {
+	out := make(chan SearchResult)
+	close(out)
+	s.Log.Debugw("sales navigator extraction not yet implemented, returning no results", "keywords", params.Keywords)
+	return out, nil
+}
@@ -0,0 +1,67 @@
+package search
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/storage"
+)
+
+// CachedIndexSource serves previously-seen profile URLs straight from store
+// instead of querying anything live - the fastest and rate-limit-free
+// source, at the cost of only ever returning what a prior LinkedInSource run
+// already found. It does a weak keyword match against the profile slug
+// since that's the only data FindPeople persists today.
+type CachedIndexSource struct {
+	Store storage.StateStore
+	Log   *zap.SugaredLogger
+}
+
+func (s *CachedIndexSource) Name() string { return "cached_index" }
+
+func (s *CachedIndexSource) Search(ctx context.Context, params SearchParams) (<-chan SearchResult, error) {
+	seen, err := loadSeenProfiles(ctx, s.Store, s.Log)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := lowerTerms(params.Keywords, params.JobTitle, params.Company, params.Location)
+
+	out := make(chan SearchResult)
+	go func() {
+		defer close(out)
+		for profileURL := range seen {
+			if len(terms) > 0 && !matchesAny(strings.ToLower(profileURL), terms) {
+				continue
+			}
+			select {
+			case out <- SearchResult{ProfileURL: profileURL}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+func lowerTerms(vals ...string) []string {
+	var out []string
+	for _, v := range vals {
+		if v == "" {
+			continue
+		}
+		out = append(out, strings.ToLower(v))
+	}
+	return out
+}
+
+func matchesAny(haystack string, terms []string) bool {
+	for _, t := range terms {
+		if strings.Contains(haystack, t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/config"
+)
+
+// CSESource finds LinkedIn profiles through a general web search engine's
+// "site:linkedin.com/in/" results instead of scraping LinkedIn directly -
+// useful when LinkedIn's own search is rate-limiting or its DOM has changed
+// out from under extractProfileURLs.
+type CSESource struct {
+	Cfg config.CSEConfig
+	Log *zap.SugaredLogger
+
+	// httpClient is overridable for tests; nil uses http.DefaultClient.
+	httpClient *http.Client
+}
+
+func (s *CSESource) Name() string { return "cse_" + strings.ToLower(s.Cfg.Provider) }
+
+func (s *CSESource) Search(ctx context.Context, params SearchParams) (<-chan SearchResult, error) {
+	if s.Cfg.APIKey == "" {
+		return nil, fmt.Errorf("cse source: api_key not configured")
+	}
+
+	query := "site:linkedin.com/in/ " + strings.Join(nonEmpty(params.Keywords, params.JobTitle, params.Company, params.Location), " ")
+
+	req, err := s.buildRequest(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s.httpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	out := make(chan SearchResult)
+	go func() {
+		defer close(out)
+
+		resp, err := client.Do(req)
+		if err != nil {
+			s.Log.Warnw("cse request failed", "provider", s.Cfg.Provider, "error", err)
+			return
+		}
+		defer resp.Body.Close()
+
+		urls, err := s.parseResponse(resp)
+		if err != nil {
+			s.Log.Warnw("cse response parse failed", "provider", s.Cfg.Provider, "error", err)
+			return
+		}
+
+		for _, u := range urls {
+			select {
+			case out <- SearchResult{ProfileURL: u}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (s *CSESource) buildRequest(ctx context.Context, query string) (*http.Request, error) {
+	switch strings.ToLower(s.Cfg.Provider) {
+	case "bing":
+		reqURL := "https://api.bing.microsoft.com/v7.0/search?q=" + url.QueryEscape(query)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Ocp-Apim-Subscription-Key", s.Cfg.APIKey)
+		return req, nil
+	default:
+		if s.Cfg.CX == "" {
+			return nil, fmt.Errorf("cse source: cx (search engine id) required for provider=google")
+		}
+		reqURL := fmt.Sprintf("https://www.googleapis.com/customsearch/v1?key=%s&cx=%s&q=%s",
+			url.QueryEscape(s.Cfg.APIKey), url.QueryEscape(s.Cfg.CX), url.QueryEscape(query))
+		return http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	}
+}
+
+func (s *CSESource) parseResponse(resp *http.Response) ([]string, error) {
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cse request returned %s", resp.Status)
+	}
+
+	switch strings.ToLower(s.Cfg.Provider) {
+	case "bing":
+		var body struct {
+			WebPages struct {
+				Value []struct {
+					URL string `json:"url"`
+				} `json:"value"`
+			} `json:"webPages"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		urls := make([]string, 0, len(body.WebPages.Value))
+		for _, v := range body.WebPages.Value {
+			urls = append(urls, v.URL)
+		}
+		return urls, nil
+	default:
+		var body struct {
+			Items []struct {
+				Link string `json:"link"`
+			} `json:"items"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		urls := make([]string, 0, len(body.Items))
+		for _, item := range body.Items {
+			urls = append(urls, item.Link)
+		}
+		return urls, nil
+	}
+}
+
+func nonEmpty(vals ...string) []string {
+	out := make([]string, 0, len(vals))
+	for _, v := range vals {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package stealth
+
+import "strings"
+
+// KeyboardLayout maps each rune to the physically-adjacent keys a human
+// typing on that layout is likely to hit by mistake. TypeHuman uses it to
+// pick typo substitutes instead of a layout-blind random letter.
+type KeyboardLayout struct {
+	Name      string
+	Neighbors map[rune][]rune
+}
+
+// neighbor returns a plausible mistyped rune for ch. Runes with no mapped
+// neighbors (e.g. punctuation outside the map) return ch itself, so callers
+// never have to special-case "no typo available".
+func (l KeyboardLayout) neighbor(r Randomizer, ch rune) rune {
+	candidates, ok := l.Neighbors[ch]
+	if !ok || len(candidates) == 0 {
+		return ch
+	}
+	return candidates[r.Intn(len(candidates))]
+}
+
+// LayoutByName resolves a config.TimingConfig.KeyboardLayout value to a
+// KeyboardLayout, defaulting to QWERTY for an empty or unrecognized name.
+func LayoutByName(name string) KeyboardLayout {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "azerty":
+		return AZERTYLayout
+	case "dvorak":
+		return DvorakLayout
+	default:
+		return QWERTYLayout
+	}
+}
+
+// QWERTYLayout covers a-z, 0-9, and the symbols typed with Shift on a
+// standard US QWERTY keyboard.
+var QWERTYLayout = KeyboardLayout{Name: "qwerty", Neighbors: map[rune][]rune{
+	'q': {'w', 'a'}, 'w': {'q', 'e', 's'}, 'e': {'w', 'r', 'd'}, 'r': {'e', 't', 'f'},
+	't': {'r', 'y', 'g'}, 'y': {'t', 'u', 'h'}, 'u': {'y', 'i', 'j'}, 'i': {'u', 'o', 'k'},
+	'o': {'i', 'p', 'l'}, 'p': {'o', 'l'},
+	'a': {'q', 's', 'z'}, 's': {'a', 'w', 'd', 'x'}, 'd': {'s', 'e', 'f', 'c'}, 'f': {'d', 'r', 'g', 'v'},
+	'g': {'f', 't', 'h', 'b'}, 'h': {'g', 'y', 'j', 'n'}, 'j': {'h', 'u', 'k', 'm'}, 'k': {'j', 'i', 'l'},
+	'l': {'k', 'o', 'p'},
+	'z': {'a', 's', 'x'}, 'x': {'z', 's', 'd', 'c'}, 'c': {'x', 'd', 'f', 'v'}, 'v': {'c', 'f', 'g', 'b'},
+	'b': {'v', 'g', 'h', 'n'}, 'n': {'b', 'h', 'j', 'm'}, 'm': {'n', 'j', 'k'},
+
+	'1': {'2', 'q'}, '2': {'1', '3', 'q', 'w'}, '3': {'2', '4', 'w', 'e'}, '4': {'3', '5', 'e', 'r'},
+	'5': {'4', '6', 'r', 't'}, '6': {'5', '7', 't', 'y'}, '7': {'6', '8', 'y', 'u'}, '8': {'7', '9', 'u', 'i'},
+	'9': {'8', '0', 'i', 'o'}, '0': {'9', 'o', 'p'},
+
+	'!': {'@', 'q'}, '@': {'!', '#', 'w'}, '#': {'@', '$', 'e'}, '$': {'#', '%', 'r'},
+	'%': {'$', '^', 't'}, '^': {'%', '&', 'y'}, '&': {'^', '*', 'u'}, '*': {'&', '(', 'i'},
+	'(': {'*', ')', 'o'}, ')': {'(', 'p'},
+}}
+
+// AZERTYLayout covers the letters and digits that move relative to QWERTY
+// on a standard French AZERTY keyboard (a/q, z/w, and m shifting next to l).
+var AZERTYLayout = KeyboardLayout{Name: "azerty", Neighbors: map[rune][]rune{
+	'a': {'z', 'q'}, 'z': {'a', 'e', 's'}, 'e': {'z', 'r', 'd'}, 'r': {'e', 't', 'f'},
+	't': {'r', 'y', 'g'}, 'y': {'t', 'u', 'h'}, 'u': {'y', 'i', 'j'}, 'i': {'u', 'o', 'k'},
+	'o': {'i', 'p', 'l'}, 'p': {'o', 'l'},
+	'q': {'a', 's', 'w'}, 's': {'q', 'z', 'd', 'x'}, 'd': {'s', 'e', 'f', 'c'}, 'f': {'d', 'r', 'g', 'v'},
+	'g': {'f', 't', 'h', 'b'}, 'h': {'g', 'y', 'j', 'n'}, 'j': {'h', 'u', 'k'}, 'k': {'j', 'i', 'l'},
+	'l': {'k', 'o', 'p', 'm'}, 'm': {'l'},
+	'w': {'q', 's', 'x'}, 'x': {'w', 's', 'd', 'c'}, 'c': {'x', 'd', 'f', 'v'}, 'v': {'c', 'f', 'g', 'b'},
+	'b': {'v', 'g', 'h', 'n'}, 'n': {'b', 'h', 'j'},
+
+	'1': {'2', 'a'}, '2': {'1', '3', 'a', 'z'}, '3': {'2', '4', 'z', 'e'}, '4': {'3', '5', 'e', 'r'},
+	'5': {'4', '6', 'r', 't'}, '6': {'5', '7', 't', 'y'}, '7': {'6', '8', 'y', 'u'}, '8': {'7', '9', 'u', 'i'},
+	'9': {'8', '0', 'i', 'o'}, '0': {'9', 'o', 'p'},
+}}
+
+// DvorakLayout covers the letters and digits of the Dvorak Simplified
+// Keyboard, whose home row ("aoeuidhtns") differs substantially from QWERTY.
+var DvorakLayout = KeyboardLayout{Name: "dvorak", Neighbors: map[rune][]rune{
+	'\'': {',', 'p'}, ',': {'\'', '.', 'a'}, '.': {',', 'p', 'o'}, 'p': {'.', 'y', 'u'},
+	'y': {'p', 'f', 'i'}, 'f': {'y', 'g', 'd'}, 'g': {'f', 'c', 'h'}, 'c': {'g', 'r', 't'},
+	'r': {'c', 'l', 'n'}, 'l': {'r', 's'},
+	'a': {',', 'o'}, 'o': {'a', '.', 'e'}, 'e': {'o', 'p', 'u'}, 'u': {'e', 'y', 'i'},
+	'i': {'u', 'f', 'd'}, 'd': {'i', 'g', 'h'}, 'h': {'d', 'c', 't'}, 't': {'h', 'r', 'n'},
+	'n': {'t', 'l', 's'}, 's': {'n', 'l'},
+	';': {'o', 'q'}, 'q': {';', 'j'}, 'j': {'q', 'k'}, 'k': {'j', 'x'}, 'x': {'k', 'b'},
+	'b': {'x', 'm'}, 'm': {'b', 'w'}, 'w': {'m', 'v'}, 'v': {'w', 'z'}, 'z': {'v'},
+
+	'1': {'2'}, '2': {'1', '3'}, '3': {'2', '4'}, '4': {'3', '5'}, '5': {'4', '6'},
+	'6': {'5', '7'}, '7': {'6', '8'}, '8': {'7', '9'}, '9': {'8', '0'}, '0': {'9'},
+}}
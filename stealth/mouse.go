@@ -1,9 +1,9 @@
 package stealth
 
 import (
+	"context"
 	"fmt"
 	"math"
-	"math/rand"
 	"time"
 
 	"github.com/go-rod/rod"
@@ -15,9 +15,10 @@ import (
 // MoveMouseHuman moves the mouse along a randomized cubic Bézier curve instead
 // of a straight line. Straight lines with constant speed are a common bot
 // signature; Bézier curves with variable speed, jitter, and occasional
-// overshoot look closer to human hand movement.
-func MoveMouseHuman(page *rod.Page, fromX, fromY, toX, toY int, cfg config.TimingConfig) error {
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+// overshoot look closer to human hand movement. ctx is checked between steps
+// so a cancelled campaign doesn't finish out a long curve before noticing.
+func MoveMouseHuman(ctx context.Context, page *rod.Page, fromX, fromY, toX, toY int, cfg config.TimingConfig) error {
+	r := defaultRandomizer
 
 	dx := float64(toX - fromX)
 	dy := float64(toY - fromY)
@@ -27,13 +28,15 @@ func MoveMouseHuman(page *rod.Page, fromX, fromY, toX, toY int, cfg config.Timin
 		return page.Mouse.MoveTo(proto.Point{X: float64(toX), Y: float64(toY)})
 	}
 
-	// Steps scale with distance; ensure minimum for curve smoothness.
-	steps := int(dist/8) + 20
-	if steps < 25 {
-		steps = 25
+	// Steps scale with distance, bounded to a 20-60 step range so a long
+	// curve still reads as one continuous motion instead of creeping along
+	// at a fixed per-step pixel count.
+	steps := int(dist/20) + 20
+	if steps < 20 {
+		steps = 20
 	}
-	if steps > 220 {
-		steps = 220
+	if steps > 60 {
+		steps = 60
 	}
 
 	// Random control points to vary curvature.
@@ -43,10 +46,12 @@ func MoveMouseHuman(page *rod.Page, fromX, fromY, toX, toY int, cfg config.Timin
 	cp2y := float64(fromY) + dy*0.7 + randRange(r, -dist*0.1, dist*0.1)
 
 	// Optional overshoot then correct back to target.
-	overshootChance := 0.22
+	overshootChance := 0.3
 	if r.Float64() < overshootChance {
-		toX += int(randRange(r, -8, 14))
-		toY += int(randRange(r, -8, 14))
+		overshootAngle := r.Float64() * 2 * math.Pi
+		overshootMag := randRange(r, 3, 15)
+		toX += int(overshootMag * math.Cos(overshootAngle))
+		toY += int(overshootMag * math.Sin(overshootAngle))
 	}
 
 	points := make([]proto.Point, 0, steps+1)
@@ -63,17 +68,34 @@ func MoveMouseHuman(page *rod.Page, fromX, fromY, toX, toY int, cfg config.Timin
 
 	// Replay points via MoveAlong so we control timing per step.
 	idx := 0
+	var moveErr error
 	err := page.Mouse.MoveAlong(func() (proto.Point, bool) {
+		if ctx.Err() != nil {
+			moveErr = ctx.Err()
+			return proto.Point{}, false
+		}
 		if idx >= len(points) {
 			return proto.Point{}, false
 		}
 		p := points[idx]
+		t := float64(idx+1) / float64(len(points))
 		idx++
-		// Variable sleep to create acceleration/deceleration feel.
-		sleep := RandomDelay(max(4, cfg.MinDelayMs/20), max(12, cfg.MinDelayMs/10))
-		time.Sleep(sleep)
+
+		// Ease-in-out: slow to start, fastest around the midpoint, slow to
+		// stop again, rather than a constant per-step delay. easeScale peaks
+		// at 1.5x the base delay at the endpoints and bottoms out at 0.5x
+		// in the middle, where hand speed is highest.
+		easeScale := 1.5 - math.Sin(math.Pi*t)
+		sleep := time.Duration(float64(RandomDelay(max(4, cfg.MinDelayMs/20), max(12, cfg.MinDelayMs/10))) * easeScale)
+		if err := SleepCtx(ctx, sleep); err != nil {
+			moveErr = err
+			return proto.Point{}, false
+		}
 		return p, true
 	})
+	if moveErr != nil {
+		return moveErr
+	}
 	if err != nil {
 		return fmt.Errorf("mouse move: %w", err)
 	}
@@ -84,7 +106,7 @@ func MoveMouseHuman(page *rod.Page, fromX, fromY, toX, toY int, cfg config.Timin
 
 // MoveToElementHuman moves the mouse to an element's center (with minor offset)
 // using the human-like curve.
-func MoveToElementHuman(page *rod.Page, el *rod.Element, cfg config.TimingConfig) error {
+func MoveToElementHuman(ctx context.Context, page *rod.Page, el *rod.Element, cfg config.TimingConfig) error {
 	// Increase timeout to 15 seconds for slower pages
 	elTimed := el.Timeout(15 * time.Second)
 
@@ -99,7 +121,7 @@ func MoveToElementHuman(page *rod.Page, el *rod.Element, cfg config.TimingConfig
 	startX := int(approxFrom[0])
 	startY := int(approxFrom[1])
 
-	return MoveMouseHuman(page, startX, startY, cx, cy, cfg)
+	return MoveMouseHuman(ctx, page, startX, startY, cx, cy, cfg)
 }
 
 func cubicBezier(p0, p1, p2, p3, t float64) float64 {
@@ -110,7 +132,7 @@ func cubicBezier(p0, p1, p2, p3, t float64) float64 {
 		t*t*t*p3
 }
 
-func randRange(r *rand.Rand, min, max float64) float64 {
+func randRange(r Randomizer, min, max float64) float64 {
 	if max < min {
 		min, max = max, min
 	}
@@ -0,0 +1,76 @@
+package stealth
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Randomizer is the source of randomness behind every human-pacing,
+// mouse-movement, and typing helper in this package. The default
+// implementation wraps a single mutex-guarded *rand.Rand seeded from
+// crypto/rand, replacing the previous pattern of each call site doing its
+// own rand.New(rand.NewSource(time.Now().UnixNano())): that's wasteful, and
+// calls landing in the same millisecond got identical sequences.
+type Randomizer interface {
+	Intn(n int) int
+	Float64() float64
+}
+
+// lockedRand adapts a *rand.Rand - not safe for concurrent use on its own -
+// into a Randomizer safe to share across goroutines (e.g. several Tasks
+// typing/scrolling concurrently through the session worker).
+type lockedRand struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+func (l *lockedRand) Intn(n int) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Intn(n)
+}
+
+func (l *lockedRand) Float64() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Float64()
+}
+
+// NewRandomizer builds a Randomizer seeded from crypto/rand, for ordinary
+// (non-reproducible) runs.
+func NewRandomizer() Randomizer {
+	return &lockedRand{r: rand.New(rand.NewSource(cryptoSeed()))}
+}
+
+// WithSeed builds a Randomizer seeded deterministically, so a --rand-seed
+// flag or a test can pin every call this package makes to the same sequence
+// (mirrors scheduler.Config.RandSeed's reproducibility story).
+func WithSeed(seed int64) Randomizer {
+	return &lockedRand{r: rand.New(rand.NewSource(seed))}
+}
+
+// cryptoSeed reads a seed from crypto/rand, falling back to the wall clock
+// only if crypto/rand itself fails - which in practice doesn't happen on
+// any supported platform.
+func cryptoSeed() int64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		return time.Now().UnixNano()
+	}
+	return int64(binary.BigEndian.Uint64(b[:]))
+}
+
+// defaultRandomizer is what RandomDelay, ShortPause, ThinkPause, TypeHuman,
+// and MoveToElementHuman draw from unless SetRandomizer has been called.
+var defaultRandomizer Randomizer = NewRandomizer()
+
+// SetRandomizer replaces the package-wide default every human-pacing helper
+// in this package draws from. Not safe to call while automation is already
+// running; call it once, before the browser session starts (e.g. from a
+// --rand-seed flag via stealth.SetRandomizer(stealth.WithSeed(seed))).
+func SetRandomizer(r Randomizer) {
+	defaultRandomizer = r
+}
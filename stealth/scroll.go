@@ -1,8 +1,9 @@
 package stealth
 
 import (
+	"context"
 	"fmt"
-	"math/rand"
+	"math"
 	"time"
 
 	"github.com/go-rod/rod"
@@ -12,119 +13,183 @@ import (
 )
 
 // ScrollFeedHuman scrolls through the LinkedIn feed in a human-like manner.
-// It performs multiple scroll actions with variable distances and pauses.
-func ScrollFeedHuman(page *rod.Page, cfg config.TimingConfig, duration time.Duration) error {
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	
+// It performs multiple scroll actions with variable distances and pauses,
+// stopping early (with ctx.Err()) if ctx is cancelled mid-scroll.
+func ScrollFeedHuman(ctx context.Context, page *rod.Page, cfg config.TimingConfig, duration time.Duration) error {
+	r := defaultRandomizer
+
 	startTime := time.Now()
 	scrollCount := 0
 	successfulScrolls := 0
 	errorCount := 0
-	
+
 	for time.Since(startTime) < duration {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		// Variable scroll distance (200-800 pixels)
 		scrollDistance := 200 + r.Intn(600)
-		
+
 		// Scroll down - use a timeout to avoid hanging
 		err := page.Timeout(5 * time.Second).Mouse.Scroll(0, float64(scrollDistance), 1)
 		scrollCount++
-		
+
 		if err != nil {
 			errorCount++
 			// Log first few errors for debugging
 			if errorCount <= 3 {
 				fmt.Printf("Scroll attempt %d failed: %v\n", scrollCount, err)
 			}
-			time.Sleep(500 * time.Millisecond)
+			if err := SleepCtx(ctx, 500*time.Millisecond); err != nil {
+				return err
+			}
 			continue
 		}
-		
+
 		successfulScrolls++
-		
+
 		// Log successful scroll every 5 scrolls
 		if successfulScrolls%5 == 0 {
 			fmt.Printf("Scrolled successfully %d times (total attempts: %d)\n", successfulScrolls, scrollCount)
 		}
-		
+
 		// Human-like pause between scrolls (1-4 seconds)
 		pauseMin := max(1000, cfg.MinDelayMs*2)
 		pauseMax := max(4000, cfg.MaxDelayMs*2)
-		time.Sleep(RandomDelay(pauseMin, pauseMax))
-		
+		if err := SleepCtx(ctx, RandomDelay(pauseMin, pauseMax)); err != nil {
+			return err
+		}
+
 		// Occasionally scroll back up slightly (mimics reading)
 		if r.Float64() < 0.25 && scrollCount > 2 {
 			smallScrollBack := 50 + r.Intn(150)
 			_ = page.Timeout(5 * time.Second).Mouse.Scroll(0, float64(-smallScrollBack), 1)
-			time.Sleep(RandomDelay(300, 800))
+			if err := SleepCtx(ctx, RandomDelay(300, 800)); err != nil {
+				return err
+			}
 		}
-		
+
 		// Occasionally pause longer (mimics reading a post)
 		if r.Float64() < 0.3 {
-			time.Sleep(RandomDelay(2000, 5000))
+			if err := SleepCtx(ctx, RandomDelay(2000, 5000)); err != nil {
+				return err
+			}
 		}
 	}
-	
+
 	fmt.Printf("Scroll complete: %d successful out of %d attempts\n", successfulScrolls, scrollCount)
-	
+
 	// If we got at least some successful scrolls, consider it a success
 	if successfulScrolls > 0 {
 		return nil
 	}
-	
+
 	return fmt.Errorf("no successful scrolls out of %d attempts (errors: %d)", scrollCount, errorCount)
 }
 
+// ScrollHuman scrolls page from its current position to targetY using
+// multiple small wheel deltas (30-120 px each) instead of a single jump,
+// with RandomDelay between deltas and an occasional 100-300ms mid-scroll
+// pause to mimic a reader stopping to look at something.
+func ScrollHuman(ctx context.Context, page *rod.Page, targetY float64, cfg config.TimingConfig) error {
+	r := defaultRandomizer
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		current, err := page.Eval(`() => window.scrollY`)
+		if err != nil {
+			return fmt.Errorf("get scroll position: %w", err)
+		}
+		remaining := targetY - current.Value.Num()
+		if math.Abs(remaining) < 1 {
+			return nil
+		}
+
+		delta := 30 + r.Float64()*90 // 30-120 px
+		if delta > math.Abs(remaining) {
+			delta = math.Abs(remaining)
+		}
+		if remaining < 0 {
+			delta = -delta
+		}
+
+		if err := page.Mouse.Scroll(0, delta, 1); err != nil {
+			return fmt.Errorf("scroll: %w", err)
+		}
+
+		if err := SleepCtx(ctx, RandomDelay(max(80, cfg.MinDelayMs/4), max(250, cfg.MaxDelayMs/4))); err != nil {
+			return err
+		}
+
+		if r.Float64() < 0.2 {
+			if err := SleepCtx(ctx, time.Duration(100+r.Intn(200))*time.Millisecond); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // ScrollToElement scrolls an element into view in a human-like way.
-func ScrollToElement(page *rod.Page, el *rod.Element, cfg config.TimingConfig) error {
+func ScrollToElement(ctx context.Context, page *rod.Page, el *rod.Element, cfg config.TimingConfig) error {
 	// Get current scroll position
 	currentScroll, err := page.Eval(`() => window.scrollY`)
 	if err != nil {
 		return fmt.Errorf("get scroll position: %w", err)
 	}
-	
+
 	// Scroll element into view
 	if err := el.ScrollIntoView(); err != nil {
 		return fmt.Errorf("scroll into view: %w", err)
 	}
-	
+
 	// Add small random pause
-	ShortPause(cfg)
-	
+	if err := ShortPause(ctx, cfg); err != nil {
+		return err
+	}
+
 	// Get new scroll position
 	newScroll, err := page.Eval(`() => window.scrollY`)
 	if err == nil && newScroll.Value.Num() != currentScroll.Value.Num() {
 		// Add small delay to mimic human reading after scroll
-		time.Sleep(RandomDelay(max(400, cfg.MinDelayMs), max(1200, cfg.MaxDelayMs)))
+		if err := SleepCtx(ctx, RandomDelay(max(400, cfg.MinDelayMs), max(1200, cfg.MaxDelayMs))); err != nil {
+			return err
+		}
 	}
-	
+
 	return nil
 }
 
 // SmoothScrollDown performs a smooth scroll down animation (more human-like than instant scroll).
-func SmoothScrollDown(page *rod.Page, distance int, cfg config.TimingConfig) error {
+func SmoothScrollDown(ctx context.Context, page *rod.Page, distance int, cfg config.TimingConfig) error {
 	// Break scroll into smaller chunks for smoothness
-	steps := 8 + rand.Intn(5) // 8-12 steps
+	steps := 8 + defaultRandomizer.Intn(5) // 8-12 steps
 	stepDistance := distance / steps
-	
+
 	for i := 0; i < steps; i++ {
 		if err := page.Mouse.Scroll(0, float64(stepDistance), 1); err != nil {
 			return err
 		}
 		// Very short delay between micro-scrolls (20-50ms)
-		time.Sleep(time.Duration(20+rand.Intn(30)) * time.Millisecond)
+		if err := SleepCtx(ctx, time.Duration(20+defaultRandomizer.Intn(30))*time.Millisecond); err != nil {
+			return err
+		}
 	}
-	
+
 	return nil
 }
 
 // ScrollWithKeyboard uses Page Down or arrow keys to scroll (alternative method).
-func ScrollWithKeyboard(page *rod.Page, scrolls int, cfg config.TimingConfig) error {
+func ScrollWithKeyboard(ctx context.Context, page *rod.Page, scrolls int, cfg config.TimingConfig) error {
 	kb := page.Keyboard
-	
+
 	for i := 0; i < scrolls; i++ {
 		// Random choice between Space, PageDown, or Arrow Down
-		choice := rand.Intn(3)
-		
+		choice := defaultRandomizer.Intn(3)
+
 		switch choice {
 		case 0:
 			if err := kb.Press(input.Space); err != nil {
@@ -136,17 +201,21 @@ func ScrollWithKeyboard(page *rod.Page, scrolls int, cfg config.TimingConfig) er
 			}
 		case 2:
 			// Press Arrow Down multiple times
-			for j := 0; j < 3+rand.Intn(3); j++ {
+			for j := 0; j < 3+defaultRandomizer.Intn(3); j++ {
 				if err := kb.Press(input.ArrowDown); err != nil {
 					return err
 				}
-				time.Sleep(time.Duration(50+rand.Intn(100)) * time.Millisecond)
+				if err := SleepCtx(ctx, time.Duration(50+defaultRandomizer.Intn(100))*time.Millisecond); err != nil {
+					return err
+				}
 			}
 		}
-		
+
 		// Pause between scroll actions
-		time.Sleep(RandomDelay(max(800, cfg.MinDelayMs), max(3000, cfg.MaxDelayMs)))
+		if err := SleepCtx(ctx, RandomDelay(max(800, cfg.MinDelayMs), max(3000, cfg.MaxDelayMs))); err != nil {
+			return err
+		}
 	}
-	
+
 	return nil
 }
@@ -1,14 +1,14 @@
 package stealth
 
 import (
-	"math/rand"
+	"context"
 	"time"
 
 	"linkedin-automation-tool/config"
 )
 
-// RandomDelay returns a duration between the given bounds (ms).
-// A seeded rand.Rand keeps randomness deterministic per run.
+// RandomDelay returns a duration between the given bounds (ms), drawn from
+// the package's shared Randomizer (see SetRandomizer).
 func RandomDelay(minMs, maxMs int) time.Duration {
 	if minMs < 0 {
 		minMs = 0
@@ -16,21 +16,37 @@ func RandomDelay(minMs, maxMs int) time.Duration {
 	if maxMs < minMs {
 		maxMs = minMs
 	}
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	n := r.Intn(maxMs-minMs+1) + minMs
+	n := defaultRandomizer.Intn(maxMs-minMs+1) + minMs
 	return time.Duration(n) * time.Millisecond
 }
 
+// SleepCtx sleeps for d, or returns ctx.Err() early if ctx is cancelled first.
+// Every human-pacing delay in this package and its callers should go through
+// this instead of time.Sleep so Ctrl-C/pause can interrupt mid-wait.
+func SleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // ShortPause simulates a brief micro delay between subtle actions.
-func ShortPause(cfg config.TimingConfig) {
-	time.Sleep(RandomDelay(max(40, cfg.MinDelayMs/6), max(80, cfg.MinDelayMs/4)))
+func ShortPause(ctx context.Context, cfg config.TimingConfig) error {
+	return SleepCtx(ctx, RandomDelay(max(40, cfg.MinDelayMs/6), max(80, cfg.MinDelayMs/4)))
 }
 
 // ThinkPause simulates a longer human hesitation before a decisive action.
-func ThinkPause(cfg config.TimingConfig) {
+func ThinkPause(ctx context.Context, cfg config.TimingConfig) error {
 	base := max(cfg.MinDelayMs, 400)
 	upper := max(cfg.MaxDelayMs, base+400)
-	time.Sleep(RandomDelay(base, upper))
+	return SleepCtx(ctx, RandomDelay(base, upper))
 }
 
 func max(a, b int) int {
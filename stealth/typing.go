@@ -2,7 +2,6 @@ package stealth
 
 import (
 	"context"
-	"math/rand"
 	"time"
 
 	"github.com/go-rod/rod"
@@ -11,14 +10,72 @@ import (
 	"linkedin-automation-tool/config"
 )
 
-// TypeHuman simulates natural typing: variable delays, slight rhythm changes,
-// and occasional single-character typos that get corrected.
-func TypeHuman(el *rod.Element, text string, cfg config.TimingConfig) error {
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+// TypingProfile tunes the per-character odds of each typo class TypeHuman
+// introduces, and which KeyboardLayout its "nearby key" typos are drawn
+// from. Zero-value fields fall back to defaultTypingProfile's rates.
+type TypingProfile struct {
+	// SubstitutionProb is the odds of typing a neighboring key instead of
+	// the right one, then backspacing and retyping it correctly.
+	SubstitutionProb float64
+	// TranspositionProb is the odds of swapping a character with the next
+	// one (a very common real-world typo LinkedIn's UI would see).
+	TranspositionProb float64
+	// InsertionProb is the odds of an extra neighboring key slipping in
+	// before the right one.
+	InsertionProb float64
+	// OmissionProb is the odds of skipping a character entirely, typing a
+	// few more, then noticing and backspace-correcting.
+	OmissionProb float64
+	Layout       KeyboardLayout
+}
+
+// defaultTypingProfile matches real-world typo-rate studies reasonably
+// well: substitutions are the most common class, followed by
+// transpositions, with insertions and omissions roughly tied for rarest.
+var defaultTypingProfile = TypingProfile{
+	SubstitutionProb:  0.02,
+	TranspositionProb: 0.01,
+	InsertionProb:     0.005,
+	OmissionProb:      0.005,
+	Layout:            QWERTYLayout,
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+// typingProfileFromConfig builds a TypingProfile from cfg, falling back to
+// defaultTypingProfile's rates for any probability left at its zero value
+// and to QWERTY for an unset/unrecognized layout name.
+func typingProfileFromConfig(cfg config.TimingConfig) TypingProfile {
+	p := defaultTypingProfile
+	if cfg.TypoSubstitutionProb > 0 {
+		p.SubstitutionProb = cfg.TypoSubstitutionProb
+	}
+	if cfg.TypoTranspositionProb > 0 {
+		p.TranspositionProb = cfg.TypoTranspositionProb
+	}
+	if cfg.TypoInsertionProb > 0 {
+		p.InsertionProb = cfg.TypoInsertionProb
+	}
+	if cfg.TypoOmissionProb > 0 {
+		p.OmissionProb = cfg.TypoOmissionProb
+	}
+	if cfg.KeyboardLayout != "" {
+		p.Layout = LayoutByName(cfg.KeyboardLayout)
+	}
+	return p
+}
+
+// TypeHuman simulates natural typing: variable delays, slight rhythm
+// changes, and the four typo classes real typists produce (substitution,
+// transposition, insertion, omission - see TypingProfile), each corrected a
+// few keystrokes later the way a person would notice and fix it. The
+// element context is derived from ctx (rather than context.Background) so
+// cancelling ctx also cuts short the element's own 30s timeout.
+func TypeHuman(ctx context.Context, el *rod.Element, text string, cfg config.TimingConfig) error {
+	r := defaultRandomizer
+	profile := typingProfileFromConfig(cfg)
+
+	typeCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
-	typed := el.Context(ctx)
+	typed := el.Context(typeCtx)
 
 	if err := typed.ScrollIntoView(); err != nil {
 		return err
@@ -29,45 +86,120 @@ func TypeHuman(el *rod.Element, text string, cfg config.TimingConfig) error {
 
 	kb := typed.Page().Keyboard
 
-	for _, ch := range text {
-		// Small chance to introduce a typo then fix it, to avoid robotic cadence.
-		if r.Float64() < 0.05 {
-			wrong := randomNearbyRune(r, ch)
-			if err := kb.Type(input.Key(wrong)); err != nil {
+	keyDelay := func() time.Duration { return RandomDelay(max(35, cfg.MinDelayMs/4), max(95, cfg.MaxDelayMs/4)) }
+	typo := func(ch rune) error {
+		if err := kb.Type(input.Key(ch)); err != nil {
+			return err
+		}
+		return SleepCtx(ctx, keyDelay())
+	}
+	backspace := func() error {
+		if err := kb.Press(input.Backspace); err != nil {
+			return err
+		}
+		return SleepCtx(ctx, keyDelay())
+	}
+
+	runes := []rune(text)
+	for i := 0; i < len(runes); {
+		ch := runes[i]
+		roll := r.Float64()
+
+		switch {
+		case roll < profile.SubstitutionProb:
+			// Type the wrong neighboring key, notice, backspace, retype correctly.
+			if err := typo(profile.Layout.neighbor(r, ch)); err != nil {
 				return err
 			}
-			time.Sleep(RandomDelay(max(25, cfg.MinDelayMs/5), max(60, cfg.MaxDelayMs/5)))
-			if err := kb.Press(input.Backspace); err != nil {
+			if err := backspace(); err != nil {
 				return err
 			}
-			time.Sleep(RandomDelay(max(25, cfg.MinDelayMs/5), max(60, cfg.MaxDelayMs/5)))
-		}
+			if err := typo(ch); err != nil {
+				return err
+			}
+			i++
 
-		if err := kb.Type(input.Key(ch)); err != nil {
-			return err
-		}
+		case roll < profile.SubstitutionProb+profile.TranspositionProb && i+1 < len(runes):
+			// Type this pair in swapped order, notice, backspace both, retype in order.
+			next := runes[i+1]
+			if err := typo(next); err != nil {
+				return err
+			}
+			if err := typo(ch); err != nil {
+				return err
+			}
+			if err := backspace(); err != nil {
+				return err
+			}
+			if err := backspace(); err != nil {
+				return err
+			}
+			if err := typo(ch); err != nil {
+				return err
+			}
+			if err := typo(next); err != nil {
+				return err
+			}
+			i += 2
 
-		// Variable delay per key to avoid constant speed.
-		time.Sleep(RandomDelay(max(35, cfg.MinDelayMs/4), max(95, cfg.MaxDelayMs/4)))
+		case roll < profile.SubstitutionProb+profile.TranspositionProb+profile.InsertionProb:
+			// Type an extra neighboring key before the right one, then fix it.
+			if err := typo(profile.Layout.neighbor(r, ch)); err != nil {
+				return err
+			}
+			if err := backspace(); err != nil {
+				return err
+			}
+			if err := typo(ch); err != nil {
+				return err
+			}
+			i++
+
+		case roll < profile.SubstitutionProb+profile.TranspositionProb+profile.InsertionProb+profile.OmissionProb:
+			// Skip ch, keep typing for a bit, then backspace back to it and retype.
+			lookahead := 1 + r.Intn(3)
+			if lookahead > len(runes)-i-1 {
+				lookahead = len(runes) - i - 1
+			}
+			for k := 1; k <= lookahead; k++ {
+				if err := typo(runes[i+k]); err != nil {
+					return err
+				}
+			}
+			for k := 0; k < lookahead; k++ {
+				if err := backspace(); err != nil {
+					return err
+				}
+			}
+			if err := typo(ch); err != nil {
+				return err
+			}
+			for k := 1; k <= lookahead; k++ {
+				if err := typo(runes[i+k]); err != nil {
+					return err
+				}
+			}
+			i += lookahead + 1
+
+		default:
+			if err := typo(ch); err != nil {
+				return err
+			}
+			i++
+		}
 
 		// Subtle rhythm change after some characters.
 		if r.Float64() < 0.12 {
-			ShortPause(cfg)
+			if err := ShortPause(ctx, cfg); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
 // Example integration (auth/login.go):
-//   MoveToElementHuman(page, emailEl, cfg.Timing)
-//   TypeHuman(emailEl, email, cfg.Timing)
-//   MoveToElementHuman(page, passwordEl, cfg.Timing)
-//   TypeHuman(passwordEl, password, cfg.Timing)
-
-func randomNearbyRune(r *rand.Rand, ch rune) rune {
-	neighbors := []rune{'a', 's', 'd', 'f', 'j', 'k', 'l', 'e', 'i', 'o'}
-	if ch >= 'a' && ch <= 'z' && len(neighbors) > 0 {
-		return neighbors[r.Intn(len(neighbors))]
-	}
-	return 'x'
-}
+//   MoveToElementHuman(ctx, page, emailEl, cfg.Timing)
+//   TypeHuman(ctx, emailEl, email, cfg.Timing)
+//   MoveToElementHuman(ctx, page, passwordEl, cfg.Timing)
+//   TypeHuman(ctx, passwordEl, password, cfg.Timing)
@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+)
+
+// EncryptedStoreKeyEnv is the environment variable EncryptedStore reads its
+// AES-256 key from: a base64-encoded 32-byte value.
+const EncryptedStoreKeyEnv = "STORAGE_ENCRYPTION_KEY"
+
+// EncryptedStore wraps any StateStore and transparently AES-GCM-encrypts the
+// blob before handing it to the inner store, so cookies, quota counters, and
+// connection-request logs aren't sitting in plaintext under data/*.json (or
+// in a shared Redis instance).
+type EncryptedStore struct {
+	inner StateStore
+	gcm   cipher.AEAD
+}
+
+// NewEncryptedStore wraps inner, loading its key from STORAGE_ENCRYPTION_KEY.
+func NewEncryptedStore(inner StateStore) (*EncryptedStore, error) {
+	keyB64 := os.Getenv(EncryptedStoreKeyEnv)
+	if keyB64 == "" {
+		return nil, fmt.Errorf("%s is not set", EncryptedStoreKeyEnv)
+	}
+	key, err := base64.StdEncoding.DecodeString(keyB64)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", EncryptedStoreKeyEnv, err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("%s must decode to 32 bytes, got %d", EncryptedStoreKeyEnv, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("build aes cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("build gcm: %w", err)
+	}
+
+	return &EncryptedStore{inner: inner, gcm: gcm}, nil
+}
+
+func (e *EncryptedStore) Save(ctx context.Context, key string, data []byte) error {
+	nonce := make([]byte, e.gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("generate nonce: %w", err)
+	}
+	sealed := e.gcm.Seal(nonce, nonce, data, nil)
+	return e.inner.Save(ctx, key, sealed)
+}
+
+func (e *EncryptedStore) Load(ctx context.Context, key string) ([]byte, error) {
+	sealed, err := e.inner.Load(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	nonceSize := e.gcm.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, fmt.Errorf("encrypted blob for %q is shorter than a nonce", key)
+	}
+	nonce, ciphertext := sealed[:nonceSize], sealed[nonceSize:]
+	plain, err := e.gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt %q: %w", key, err)
+	}
+	return plain, nil
+}
+
+func (e *EncryptedStore) Delete(ctx context.Context, key string) error {
+	return e.inner.Delete(ctx, key)
+}
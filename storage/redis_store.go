@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisConfig configures a RedisStateStore. Either Addr (direct connection)
+// or SentinelAddrs+MasterName (HA via Sentinel) should be set, mirroring the
+// connection options oauth2_proxy exposes for its Redis session store.
+type RedisConfig struct {
+	Addr          string   // e.g. "localhost:6379" - direct connection
+	SentinelAddrs []string // Sentinel node addresses
+	MasterName    string   // Sentinel master name, required when SentinelAddrs is set
+	Password      string
+	DB            int
+	KeyPrefix     string // Prefix applied to every key, e.g. "linkedin:"
+	// TTL expires keys after the given duration. Zero means no expiry,
+	// matching Redis's own SET default.
+	TTL time.Duration
+}
+
+// RedisStateStore persists state in Redis, keyed by KeyPrefix+key. Unlike
+// FileStore it is safe for multiple processes/accounts to share, which is
+// what the ticket-based session scheme in auth.SessionTicket relies on.
+type RedisStateStore struct {
+	client *redis.Client
+	prefix string
+	ttl    time.Duration
+}
+
+// NewRedisStateStore builds a RedisStateStore from cfg, connecting either
+// directly or through Sentinel depending on which fields are populated.
+func NewRedisStateStore(cfg RedisConfig) (*RedisStateStore, error) {
+	var client *redis.Client
+
+	switch {
+	case len(cfg.SentinelAddrs) > 0:
+		if cfg.MasterName == "" {
+			return nil, errors.New("redis sentinel requires a master name")
+		}
+		client = redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:    cfg.MasterName,
+			SentinelAddrs: cfg.SentinelAddrs,
+			Password:      cfg.Password,
+			DB:            cfg.DB,
+		})
+	case cfg.Addr != "":
+		client = redis.NewClient(&redis.Options{
+			Addr:     cfg.Addr,
+			Password: cfg.Password,
+			DB:       cfg.DB,
+		})
+	default:
+		return nil, errors.New("redis store requires Addr or SentinelAddrs")
+	}
+
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		return nil, fmt.Errorf("ping redis: %w", err)
+	}
+
+	return &RedisStateStore{client: client, prefix: cfg.KeyPrefix, ttl: cfg.TTL}, nil
+}
+
+func (r *RedisStateStore) fullKey(key string) string {
+	return r.prefix + key
+}
+
+func (r *RedisStateStore) Save(ctx context.Context, key string, data []byte) error {
+	if key == "" {
+		return errors.New("empty key")
+	}
+	if err := r.client.Set(ctx, r.fullKey(key), data, r.ttl).Err(); err != nil {
+		return fmt.Errorf("redis set %q: %w", key, err)
+	}
+	return nil
+}
+
+func (r *RedisStateStore) Load(ctx context.Context, key string) ([]byte, error) {
+	if key == "" {
+		return nil, errors.New("empty key")
+	}
+	b, err := r.client.Get(ctx, r.fullKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("redis get %q: %w", key, err)
+	}
+	return b, nil
+}
+
+func (r *RedisStateStore) Delete(ctx context.Context, key string) error {
+	if key == "" {
+		return errors.New("empty key")
+	}
+	if err := r.client.Del(ctx, r.fullKey(key)).Err(); err != nil {
+		return fmt.Errorf("redis del %q: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package tui
+
+import "strings"
+
+// LogSink is a zapcore.WriteSyncer that forwards each log entry onto a
+// channel instead of (or in addition to, via logger.NewTee) a file or
+// stdout, so the dashboard's log panel can tail it without scraping output.
+type LogSink struct {
+	ch chan string
+}
+
+// NewLogSink builds a LogSink buffering up to capacity lines. Once full,
+// further writes are dropped rather than blocking the logger.
+func NewLogSink(capacity int) *LogSink {
+	return &LogSink{ch: make(chan string, capacity)}
+}
+
+// Write implements io.Writer (and so zapcore.WriteSyncer alongside Sync).
+func (s *LogSink) Write(p []byte) (int, error) {
+	line := strings.TrimRight(string(p), "\n")
+	select {
+	case s.ch <- line:
+	default:
+		// Drop the line; a slow-reading TUI shouldn't back-pressure logging.
+	}
+	return len(p), nil
+}
+
+// Sync implements zapcore.WriteSyncer. There's nothing to flush.
+func (s *LogSink) Sync() error { return nil }
@@ -0,0 +1,274 @@
+package tui
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/agent"
+	"linkedin-automation-tool/metrics"
+	"linkedin-automation-tool/storage"
+)
+
+// panel identifies which of the dashboard's five views is active.
+type panel int
+
+const (
+	panelLogs panel = iota
+	panelPatterns
+	panelSearch
+	panelQuotas
+	panelProfiles
+)
+
+var panelTitles = map[panel]string{
+	panelLogs:     "Logs",
+	panelPatterns: "Navigation Patterns",
+	panelSearch:   "Search",
+	panelQuotas:   "Daily Quotas",
+	panelProfiles: "Profiles",
+}
+
+// navPattern pairs a display name with the navigation.NavigationPattern it
+// dispatches as the "navigate" task's "pattern" param.
+type navPattern struct {
+	label string
+	param string
+}
+
+var navPatterns = []navPattern{
+	{"Quick Tour", "quick_tour"},
+	{"Networking", "networking"},
+	{"Job Search", "job_search"},
+	{"Casual Browsing", "casual_browsing"},
+}
+
+const maxLogLines = 200
+
+// searchField indexes the textinput.Model slice backing the search form.
+const (
+	searchFieldKeywords = iota
+	searchFieldLocation
+	searchFieldMaxPages
+	searchFieldCount
+)
+
+// model is the Bubble Tea model driving the dashboard. It never touches rod
+// directly - every action goes through ag.Dispatch or reads ag's cached
+// state, so the TUI and headless modes stay behaviorally identical.
+type model struct {
+	ag     *agent.Agent
+	store  storage.StateStore
+	limits metrics.QuotaLimits
+	logCh  <-chan string
+	log    *zap.SugaredLogger
+
+	active panel
+	status string
+
+	logLines []string
+
+	patternCursor int
+
+	searchInputs []textinput.Model
+	searchFocus  int
+
+	quotaRemaining map[string]int
+
+	profiles       []string
+	profileCursor  int
+	profileChecked map[int]bool
+
+	width, height int
+}
+
+func newModel(ag *agent.Agent, store storage.StateStore, limits metrics.QuotaLimits, logCh <-chan string, log *zap.SugaredLogger) model {
+	keywords := textinput.New()
+	keywords.Placeholder = "software engineer"
+	keywords.Focus()
+
+	location := textinput.New()
+	location.Placeholder = "India"
+
+	maxPages := textinput.New()
+	maxPages.Placeholder = "3"
+
+	return model{
+		ag:             ag,
+		store:          store,
+		limits:         limits,
+		logCh:          logCh,
+		log:            log,
+		searchInputs:   []textinput.Model{keywords, location, maxPages},
+		profileChecked: make(map[int]bool),
+	}
+}
+
+type logLineMsg string
+type tickMsg time.Time
+
+func waitForLog(ch <-chan string) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-ch
+		if !ok {
+			return nil
+		}
+		return logLineMsg(line)
+	}
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(2*time.Second, func(t time.Time) tea.Msg { return tickMsg(t) })
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(waitForLog(m.logCh), tick())
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case logLineMsg:
+		m.logLines = append(m.logLines, string(msg))
+		if len(m.logLines) > maxLogLines {
+			m.logLines = m.logLines[len(m.logLines)-maxLogLines:]
+		}
+		return m, waitForLog(m.logCh)
+
+	case tickMsg:
+		m.profiles = m.ag.Profiles()
+		m.quotaRemaining = metrics.RemainingToday(context.Background(), m.store, m.limits, m.log)
+		return m, tick()
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+func (m model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "1":
+		m.active = panelLogs
+		return m, nil
+	case "2":
+		m.active = panelPatterns
+		return m, nil
+	case "3":
+		m.active = panelSearch
+		return m, nil
+	case "4":
+		m.active = panelQuotas
+		return m, nil
+	case "5":
+		m.active = panelProfiles
+		return m, nil
+	}
+
+	switch m.active {
+	case panelPatterns:
+		return m.handlePatternsKey(msg)
+	case panelSearch:
+		return m.handleSearchKey(msg)
+	case panelProfiles:
+		return m.handleProfilesKey(msg)
+	}
+
+	return m, nil
+}
+
+func (m model) handlePatternsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.patternCursor > 0 {
+			m.patternCursor--
+		}
+	case "down", "j":
+		if m.patternCursor < len(navPatterns)-1 {
+			m.patternCursor++
+		}
+	case "enter":
+		p := navPatterns[m.patternCursor]
+		if err := m.ag.Dispatch("navigate", map[string]interface{}{"pattern": p.param}); err != nil {
+			m.status = "dispatch failed: " + err.Error()
+		} else {
+			m.status = "dispatched navigate: " + p.label
+		}
+	}
+	return m, nil
+}
+
+func (m model) handleSearchKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "tab":
+		m.searchInputs[m.searchFocus].Blur()
+		m.searchFocus = (m.searchFocus + 1) % searchFieldCount
+		m.searchInputs[m.searchFocus].Focus()
+		return m, nil
+	case "enter":
+		maxPages, _ := strconv.Atoi(m.searchInputs[searchFieldMaxPages].Value())
+		params := map[string]interface{}{
+			"keywords":  m.searchInputs[searchFieldKeywords].Value(),
+			"location":  m.searchInputs[searchFieldLocation].Value(),
+			"max_pages": maxPages,
+		}
+		if err := m.ag.Dispatch("search", params); err != nil {
+			m.status = "dispatch failed: " + err.Error()
+		} else {
+			m.status = "dispatched search"
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.searchInputs[m.searchFocus], cmd = m.searchInputs[m.searchFocus].Update(msg)
+	return m, cmd
+}
+
+func (m model) handleProfilesKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.profileCursor > 0 {
+			m.profileCursor--
+		}
+	case "down", "j":
+		if m.profileCursor < len(m.profiles)-1 {
+			m.profileCursor++
+		}
+	case " ":
+		m.profileChecked[m.profileCursor] = !m.profileChecked[m.profileCursor]
+	case "c":
+		selected := m.selectedProfiles()
+		if len(selected) == 0 {
+			m.status = "no profiles selected"
+			return m, nil
+		}
+		m.ag.SetProfiles(selected)
+		if err := m.ag.Dispatch("connect", map[string]interface{}{}); err != nil {
+			m.status = "dispatch failed: " + err.Error()
+		} else {
+			m.status = "dispatched connect for " + strconv.Itoa(len(selected)) + " profiles"
+		}
+	}
+	return m, nil
+}
+
+func (m model) selectedProfiles() []string {
+	var selected []string
+	for i, url := range m.profiles {
+		if m.profileChecked[i] {
+			selected = append(selected, url)
+		}
+	}
+	return selected
+}
@@ -0,0 +1,31 @@
+// Package tui is a Bubble Tea dashboard offering the same campaign controls
+// as the control package's HTTP API, for users who'd rather drive the agent
+// from a terminal than curl. It shares agent.Agent and the Task interface
+// with headless mode: every action here goes through ag.Dispatch/Submit, so
+// browser access stays serialized on the agent's own session-worker
+// goroutine and the two modes behave identically.
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"go.uber.org/zap"
+
+	"linkedin-automation-tool/agent"
+	"linkedin-automation-tool/metrics"
+	"linkedin-automation-tool/storage"
+)
+
+// Run launches the dashboard and blocks until the user quits or ctx is done.
+// ag must already be started (agent.Agent.Start) before calling Run.
+func Run(ctx context.Context, ag *agent.Agent, store storage.StateStore, limits metrics.QuotaLimits, sink *LogSink, log *zap.SugaredLogger) error {
+	m := newModel(ag, store, limits, sink.ch, log)
+
+	p := tea.NewProgram(m, tea.WithContext(ctx))
+	if _, err := p.Run(); err != nil {
+		return fmt.Errorf("tui: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+func (m model) View() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "LinkedIn Automation Tool  [1] %s  [2] %s  [3] %s  [4] %s  [5] %s  (q to quit)\n\n",
+		panelTitles[panelLogs], panelTitles[panelPatterns], panelTitles[panelSearch], panelTitles[panelQuotas], panelTitles[panelProfiles])
+
+	fmt.Fprintf(&b, "== %s ==\n\n", panelTitles[m.active])
+
+	switch m.active {
+	case panelLogs:
+		b.WriteString(m.viewLogs())
+	case panelPatterns:
+		b.WriteString(m.viewPatterns())
+	case panelSearch:
+		b.WriteString(m.viewSearch())
+	case panelQuotas:
+		b.WriteString(m.viewQuotas())
+	case panelProfiles:
+		b.WriteString(m.viewProfiles())
+	}
+
+	if m.status != "" {
+		fmt.Fprintf(&b, "\n%s\n", m.status)
+	}
+
+	return b.String()
+}
+
+func (m model) viewLogs() string {
+	if len(m.logLines) == 0 {
+		return "(no log output yet)\n"
+	}
+
+	lines := m.logLines
+	if maxVisible := 20; len(lines) > maxVisible {
+		lines = lines[len(lines)-maxVisible:]
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (m model) viewPatterns() string {
+	var b strings.Builder
+	b.WriteString("up/down to select, enter to dispatch\n\n")
+	for i, p := range navPatterns {
+		cursor := "  "
+		if i == m.patternCursor {
+			cursor = "> "
+		}
+		fmt.Fprintf(&b, "%s%s\n", cursor, p.label)
+	}
+	return b.String()
+}
+
+func (m model) viewSearch() string {
+	labels := []string{"Keywords", "Location", "Max Pages"}
+
+	var b strings.Builder
+	b.WriteString("tab to move between fields, enter to dispatch\n\n")
+	for i, input := range m.searchInputs {
+		fmt.Fprintf(&b, "%-10s %s\n", labels[i]+":", input.View())
+	}
+	return b.String()
+}
+
+func (m model) viewQuotas() string {
+	if m.quotaRemaining == nil {
+		return "(quota data not loaded yet)\n"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Connection requests remaining today: %d\n", m.quotaRemaining["connection_request"])
+	fmt.Fprintf(&b, "Messages remaining today:            %d\n", m.quotaRemaining["message"])
+	return b.String()
+}
+
+func (m model) viewProfiles() string {
+	if len(m.profiles) == 0 {
+		return "(no profiles found yet - run a search from the Search panel)\n"
+	}
+
+	columns := []table.Column{
+		{Title: "", Width: 2},
+		{Title: "Profile URL", Width: 60},
+	}
+
+	rows := make([]table.Row, len(m.profiles))
+	for i, url := range m.profiles {
+		mark := " "
+		if m.profileChecked[i] {
+			mark = "x"
+		}
+		rows[i] = table.Row{mark, url}
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetCursor(m.profileCursor)
+
+	var b strings.Builder
+	b.WriteString("up/down to move, space to toggle, c to connect selected\n\n")
+	b.WriteString(t.View())
+	b.WriteString("\n\nselected: " + strconv.Itoa(len(m.selectedProfiles())))
+	return b.String()
+}